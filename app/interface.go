@@ -109,6 +109,11 @@ type WindowNavigator interface {
 	// ShowModal displays a modal over the current page. Any previously
 	// displayed modal will be hidden by this new modal.
 	ShowModal(Modal)
+	// ShowModalOnce displays a modal over the current page unless a modal
+	// that was previously displayed with the same key is still displayed.
+	// This prevents identical modals from piling up when the same error is
+	// reported repeatedly. Returns true if the modal was displayed.
+	ShowModalOnce(key string, modal Modal) bool
 	// DismissModal dismisses the modal with the specified ID, if it was
 	// previously displayed by this WindowNavigator. If there are more than 1
 	// modal with the specified ID, only the top-most instance is dismissed.
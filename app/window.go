@@ -12,8 +12,9 @@ type SimpleWindowNavigator struct {
 	reloadDisplayFn func()
 	subPages        *PageStack
 
-	modalMutex sync.Mutex
-	modals     []Modal
+	modalMutex  sync.Mutex
+	modals      []Modal
+	keyedModals map[string]Modal // modals displayed using ShowModalOnce
 }
 
 // NewSimpleWindowNavigator creates an instance of a SimpleWindowNavigator.
@@ -21,6 +22,7 @@ func NewSimpleWindowNavigator(reloadDisplayFn func()) *SimpleWindowNavigator {
 	w := &SimpleWindowNavigator{
 		reloadDisplayFn: reloadDisplayFn,
 		subPages:        NewPageStack("main window"),
+		keyedModals:     make(map[string]Modal),
 	}
 	return w
 }
@@ -106,6 +108,34 @@ func (window *SimpleWindowNavigator) ShowModal(modal Modal) {
 	window.Reload()
 }
 
+// ShowModalOnce displays a modal over the current page unless a modal that was
+// previously displayed with the same key is still displayed. Returns true if
+// the modal was displayed.
+// Part of the WindowNavigator interface.
+func (window *SimpleWindowNavigator) ShowModalOnce(key string, modal Modal) bool {
+	window.modalMutex.Lock()
+	if existing, ok := window.keyedModals[key]; ok && window.isModalDisplayed(existing) {
+		window.modalMutex.Unlock()
+		return false
+	}
+	window.keyedModals[key] = modal
+	window.modalMutex.Unlock()
+
+	window.ShowModal(modal)
+	return true
+}
+
+// isModalDisplayed checks if the specified modal instance is displayed. The
+// modalMutex MUST be held when calling this method.
+func (window *SimpleWindowNavigator) isModalDisplayed(modal Modal) bool {
+	for _, m := range window.modals {
+		if m == modal {
+			return true
+		}
+	}
+	return false
+}
+
 // DismissModal dismisses the modal with the specified ID, if it was previously
 // displayed by this WindowNavigator. If there are more than 1 modal with the
 // specified ID, only the top-most instance is dismissed.
@@ -122,6 +152,11 @@ func (window *SimpleWindowNavigator) DismissModal(modalID string) {
 			break
 		}
 	}
+	for key, modal := range window.keyedModals {
+		if modal == modalToDismiss {
+			delete(window.keyedModals, key)
+		}
+	}
 	window.modalMutex.Unlock()
 
 	if modalToDismiss != nil {
@@ -729,3 +729,45 @@ func numerate(n int) string {
 		return nStr + "th"
 	}
 }
+
+func TestShowModalOnce(t *testing.T) {
+	tWindow := &testGiouiWindow{}
+	windowNavigator := NewSimpleWindowNavigator(tWindow.invalidate)
+	tWindow.topModalGetter = windowNavigator.TopModal
+	tWindow.currentPageGetter = windowNavigator.CurrentPage
+
+	modalCount := func() int {
+		windowNavigator.modalMutex.Lock()
+		defer windowNavigator.modalMutex.Unlock()
+		return len(windowNavigator.modals)
+	}
+
+	tests := []struct {
+		name         string
+		key          string
+		modalID      string
+		dismissFirst string // ID of a modal to dismiss before showing.
+		wantShown    bool
+		wantCount    int
+	}{
+		{name: "first modal", key: "err", modalID: "modal1", wantShown: true, wantCount: 1},
+		{name: "same key while displayed", key: "err", modalID: "modal2", wantShown: false, wantCount: 1},
+		{name: "different key", key: "other", modalID: "modal3", wantShown: true, wantCount: 2},
+		{name: "same key after dismiss", key: "err", modalID: "modal4", dismissFirst: "modal1", wantShown: true, wantCount: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.dismissFirst != "" {
+				windowNavigator.DismissModal(tt.dismissFirst)
+			}
+			shown := windowNavigator.ShowModalOnce(tt.key, newTestModal(tt.modalID, t.Logf))
+			if shown != tt.wantShown {
+				t.Fatalf("expected ShowModalOnce to return %v, got %v", tt.wantShown, shown)
+			}
+			if count := modalCount(); count != tt.wantCount {
+				t.Fatalf("expected %d displayed modals, found %d", tt.wantCount, count)
+			}
+		})
+	}
+}
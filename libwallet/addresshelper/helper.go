@@ -2,8 +2,10 @@ package addresshelper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
 	btccfg "github.com/btcsuite/btcd/chaincfg"
 	btctxscript "github.com/btcsuite/btcd/txscript"
 	"github.com/decred/dcrd/chaincfg/v3"
@@ -15,7 +17,19 @@ import (
 	ltctxscript "github.com/ltcsuite/ltcd/txscript"
 )
 
-const scriptVersion = 0
+const (
+	scriptVersion = 0
+
+	// addressDisplayGroupSize is the number of characters in each chunk of an
+	// address formatted for display.
+	addressDisplayGroupSize = 4
+)
+
+// BTCAddressInfo describes the type and network of a decoded BTC address.
+type BTCAddressInfo struct {
+	Type    string
+	Network string
+}
 
 // PkScript returns the public key payment script for the given address.
 func PkScript(address string, net dcrutil.AddressParams) ([]byte, error) {
@@ -48,6 +62,62 @@ func BTCPkScript(address string, net *btccfg.Params) ([]byte, error) {
 	return pkScript, nil
 }
 
+// DecodeBTCAddress strictly validates the provided address against the given
+// network and returns its type and network. The checksum of bech32 and
+// bech32m (segwit) addresses is verified before any further decoding so that
+// mistyped addresses are rejected with a clear message.
+func DecodeBTCAddress(address string, net *btccfg.Params) (*BTCAddressInfo, error) {
+	if strings.HasPrefix(strings.ToLower(address), net.Bech32HRPSegwit+"1") {
+		if _, _, _, err := bech32.DecodeGeneric(address); err != nil {
+			return nil, fmt.Errorf("invalid bech32 address: %v", err)
+		}
+	}
+
+	addr, err := btcutil.DecodeAddress(address, net)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding address '%s': %s", address, err.Error())
+	}
+
+	if !addr.IsForNet(net) {
+		return nil, fmt.Errorf("address '%s' is not a %s address", address, net.Name)
+	}
+
+	info := &BTCAddressInfo{Network: net.Name}
+	switch addr.(type) {
+	case *btcutil.AddressPubKeyHash:
+		info.Type = "P2PKH"
+	case *btcutil.AddressScriptHash:
+		info.Type = "P2SH"
+	case *btcutil.AddressWitnessPubKeyHash:
+		info.Type = "P2WPKH"
+	case *btcutil.AddressWitnessScriptHash:
+		info.Type = "P2WSH"
+	case *btcutil.AddressTaproot:
+		info.Type = "P2TR"
+	case *btcutil.AddressPubKey:
+		info.Type = "P2PK"
+	default:
+		return nil, fmt.Errorf("unsupported address type %T", addr)
+	}
+
+	return info, nil
+}
+
+// FormatAddressForDisplay splits the provided address into space separated
+// chunks of characters, making it easier for users to visually compare it with
+// the address they intended to send to.
+func FormatAddressForDisplay(address string) string {
+	chunks := make([]string, 0, len(address)/addressDisplayGroupSize+1)
+	for len(address) > addressDisplayGroupSize {
+		chunks = append(chunks, address[:addressDisplayGroupSize])
+		address = address[addressDisplayGroupSize:]
+	}
+	if address != "" {
+		chunks = append(chunks, address)
+	}
+	return strings.Join(chunks, " ")
+}
+
 // LTCPkScript returns the public key payment script for the given address.
 func LTCPkScript(address string, net *ltccfg.Params) ([]byte, error) {
 	// Parse the address to send the coins to into a ltcutil.Address
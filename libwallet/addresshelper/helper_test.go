@@ -0,0 +1,105 @@
+package addresshelper
+
+import (
+	"testing"
+
+	btccfg "github.com/btcsuite/btcd/chaincfg"
+)
+
+func TestDecodeBTCAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		net      *btccfg.Params
+		wantType string
+		wantErr  bool
+	}{{
+		name:     "mainnet p2wpkh",
+		address:  "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		net:      &btccfg.MainNetParams,
+		wantType: "P2WPKH",
+	}, {
+		name:     "uppercase mainnet p2wpkh",
+		address:  "BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+		net:      &btccfg.MainNetParams,
+		wantType: "P2WPKH",
+	}, {
+		name:     "mainnet p2tr",
+		address:  "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+		net:      &btccfg.MainNetParams,
+		wantType: "P2TR",
+	}, {
+		name:     "testnet p2wpkh",
+		address:  "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		net:      &btccfg.TestNet3Params,
+		wantType: "P2WPKH",
+	}, {
+		name:     "mainnet p2pkh",
+		address:  "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		net:      &btccfg.MainNetParams,
+		wantType: "P2PKH",
+	}, {
+		name:     "mainnet p2sh",
+		address:  "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+		net:      &btccfg.MainNetParams,
+		wantType: "P2SH",
+	}, {
+		name:    "broken bech32 checksum",
+		address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+		net:     &btccfg.MainNetParams,
+		wantErr: true,
+	}, {
+		name:    "broken bech32m checksum",
+		address: "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj1",
+		net:     &btccfg.MainNetParams,
+		wantErr: true,
+	}, {
+		name:    "mixed case bech32",
+		address: "bc1qW508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		net:     &btccfg.MainNetParams,
+		wantErr: true,
+	}, {
+		name:    "wrong network",
+		address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		net:     &btccfg.MainNetParams,
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := DecodeBTCAddress(test.address, test.net)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %s, got none", test.address)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if info.Type != test.wantType {
+				t.Fatalf("expected address type %s, got %s", test.wantType, info.Type)
+			}
+			if info.Network != test.net.Name {
+				t.Fatalf("expected network %s, got %s", test.net.Name, info.Network)
+			}
+		})
+	}
+}
+
+func TestFormatAddressForDisplay(t *testing.T) {
+	tests := []struct {
+		address, want string
+	}{
+		{"", ""},
+		{"bc1q", "bc1q"},
+		{"bc1qw5", "bc1q w5"},
+		{"bc1qw508d6qe", "bc1q w508 d6qe"},
+	}
+
+	for _, test := range tests {
+		if got := FormatAddressForDisplay(test.address); got != test.want {
+			t.Errorf("FormatAddressForDisplay(%q): expected %q, got %q", test.address, test.want, got)
+		}
+	}
+}
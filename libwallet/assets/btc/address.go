@@ -2,9 +2,14 @@ package btc
 
 import (
 	"fmt"
+	"math"
 
 	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/crypto-power/cryptopower/libwallet/addresshelper"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 )
 
@@ -19,9 +24,19 @@ type AddressInfo struct {
 	AccountName   string
 }
 
-// IsAddressValid checks if the provided address is valid.
+// AddressBalance holds the balance of a single address owned by the wallet.
+// Outputs with fewer than the required number of confirmations are counted
+// in Unconfirmed.
+type AddressBalance struct {
+	Address     string
+	Confirmed   sharedW.AssetAmount
+	Unconfirmed sharedW.AssetAmount
+}
+
+// IsAddressValid checks if the provided address is valid for the wallet's
+// network. The checksum of segwit addresses is verified too.
 func (asset *Asset) IsAddressValid(address string) bool {
-	_, err := btcutil.DecodeAddress(address, asset.chainParams)
+	_, err := addresshelper.DecodeBTCAddress(address, asset.chainParams)
 	return err == nil
 }
 
@@ -176,3 +191,83 @@ func (asset *Asset) AddressPubKey(address string) (string, error) {
 	}
 	return pubKeyAddr.String(), nil
 }
+
+// AddressBalance returns the confirmed and unconfirmed balance of the provided
+// address, computed from the wallet's unspent outputs. Outputs that are
+// locked are not included. An error is returned if the address does not
+// belong to the wallet.
+func (asset *Asset) AddressBalance(address string) (*AddressBalance, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	addr, err := btcutil.DecodeAddress(address, asset.chainParams)
+	if err != nil {
+		return nil, err
+	}
+
+	isMine, err := asset.Internal().BTC.HaveAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	if !isMine {
+		return nil, fmt.Errorf("address does not belong to the wallet")
+	}
+
+	// List the unspent outputs of all accounts, unconfirmed ones included.
+	unspents, err := asset.Internal().BTC.ListUnspent(0, math.MaxInt32, "")
+	if err != nil {
+		return nil, err
+	}
+
+	confirmed, unconfirmed := sumAddressBalance(unspents, addr.EncodeAddress(), asset.RequiredConfirmations())
+	return &AddressBalance{
+		Address:     address,
+		Confirmed:   Amount(confirmed),
+		Unconfirmed: Amount(unconfirmed),
+	}, nil
+}
+
+// sumAddressBalance totals the unspent outputs paying to address, splitting
+// them by whether they have at least requiredConfs confirmations.
+func sumAddressBalance(unspents []*btcjson.ListUnspentResult, address string, requiredConfs int32) (confirmed, unconfirmed btcutil.Amount) {
+	for _, utxo := range unspents {
+		if utxo.Address != address {
+			continue
+		}
+
+		// error returned is ignored because the amount value is from upstream
+		// and doesn't require an extra layer of validation.
+		amount, _ := btcutil.NewAmount(utxo.Amount)
+		if utxo.Confirmations >= int64(requiredConfs) {
+			confirmed += amount
+		} else {
+			unconfirmed += amount
+		}
+	}
+	return confirmed, unconfirmed
+}
+
+// isAddressUsed returns true if the wallet has seen a transaction paying to
+// the provided address.
+func (asset *Asset) isAddressUsed(address string) bool {
+	addr, err := btcutil.DecodeAddress(address, asset.chainParams)
+	if err != nil {
+		return false
+	}
+
+	managedAddr, err := asset.Internal().BTC.AddressInfo(addr)
+	if err != nil {
+		return false
+	}
+
+	var used bool
+	err = walletdb.View(asset.Internal().BTC.Database(), func(dbtx walletdb.ReadTx) error {
+		used = managedAddr.Used(dbtx.ReadBucket(wAddrMgrBkt))
+		return nil
+	})
+	if err != nil {
+		log.Errorf("failed to check if address %s is used: %v", address, err)
+	}
+	return used
+}
@@ -0,0 +1,63 @@
+package btc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+func TestSumAddressBalance(t *testing.T) {
+	const (
+		addrA = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+		addrB = "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	)
+
+	unspents := []*btcjson.ListUnspentResult{
+		{Address: addrA, Amount: 0.5, Confirmations: 6},
+		{Address: addrA, Amount: 0.25, Confirmations: 1},
+		{Address: addrA, Amount: 0.00000001, Confirmations: 0},
+		{Address: addrB, Amount: 2, Confirmations: 100},
+	}
+
+	tests := []struct {
+		name            string
+		address         string
+		requiredConfs   int32
+		wantConfirmed   btcutil.Amount
+		wantUnconfirmed btcutil.Amount
+	}{{
+		name:            "single confirmation required",
+		address:         addrA,
+		requiredConfs:   1,
+		wantConfirmed:   75000000,
+		wantUnconfirmed: 1,
+	}, {
+		name:            "six confirmations required",
+		address:         addrA,
+		requiredConfs:   6,
+		wantConfirmed:   50000000,
+		wantUnconfirmed: 25000001,
+	}, {
+		name:          "other address",
+		address:       addrB,
+		requiredConfs: 1,
+		wantConfirmed: 200000000,
+	}, {
+		name:          "address without outputs",
+		address:       "3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+		requiredConfs: 1,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			confirmed, unconfirmed := sumAddressBalance(unspents, test.address, test.requiredConfs)
+			if confirmed != test.wantConfirmed {
+				t.Fatalf("expected confirmed balance %v, got %v", test.wantConfirmed, confirmed)
+			}
+			if unconfirmed != test.wantUnconfirmed {
+				t.Fatalf("expected unconfirmed balance %v, got %v", test.wantUnconfirmed, unconfirmed)
+			}
+		})
+	}
+}
@@ -35,7 +35,6 @@ import (
 	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/decred/slog"
 	"github.com/jrick/logrotate/rotator"
-	"github.com/lightninglabs/neutrino"
 )
 
 // dexLogLevel is used to reactivate logger after metered logging.
@@ -1020,26 +1019,12 @@ type btcChainService struct {
 }
 
 func (s *btcChainService) Peers() []dexbtc.SPVPeer {
-	// *neutrino.ChainService.Peers() may stall, especially if the wallet hasn't
-	// started sync yet. Call the method in a goroutine and wait below to see if
-	// we get a response. Return an empty slice if we don't get a response after
-	// waiting briefly.
-	rawPeersChan := make(chan []*neutrino.ServerPeer)
-	go func() {
-		rawPeersChan <- s.CS.(ExtraNeutrinoChainService).Peers()
-	}()
-
-	select {
-	case rawPeers := <-rawPeersChan:
-		peers := make([]dexbtc.SPVPeer, 0, len(rawPeers))
-		for _, p := range rawPeers {
-			peers = append(peers, p)
-		}
-		return peers
-
-	case <-time.After(2 * time.Second):
-		return nil // CS.Peers() is taking too long to respond
+	rawPeers := chainServicePeers(s.CS.(ExtraNeutrinoChainService))
+	peers := make([]dexbtc.SPVPeer, 0, len(rawPeers))
+	for _, p := range rawPeers {
+		peers = append(peers, p)
 	}
+	return peers
 }
 
 // Fingerprint returns an identifier for this wallet. It is the hash of the
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"decred.org/dcrwallet/v4/errors"
 	"github.com/btcsuite/btcd/btcutil"
@@ -49,7 +50,7 @@ type feeEstimateCache struct {
 	// all his transactions.
 	SetFeeRatePerkvB sharedW.AssetAmount
 	// If not empty, they hold the fee rate queries from the API when the best
-	// block was set at LastBestBlock.
+	// block was set at LastBestBlock, sorted by confirmation target.
 	APIFeeRates []sharedW.FeeEstimate
 	// LastBestblock defines the last height when results were cached. This
 	// helps to keep the API calls to under control.
@@ -116,7 +117,7 @@ func (asset *Asset) GetAPIFeeEstimateRate() (feerates []sharedW.FeeEstimate, err
 
 	// If best block hasn't changed, return the cached estimates.
 	if asset.GetBestBlockHeight() == lastblock && lastblock > 0 {
-		return feerates, nil
+		return topFeeRates(feerates), nil
 	}
 
 	feerates, err = asset.fetchAPIFeeRate()
@@ -133,27 +134,116 @@ func (asset *Asset) GetAPIFeeEstimateRate() (feerates []sharedW.FeeEstimate, err
 		return feerates[i].ConfirmedBlocks < feerates[j].ConfirmedBlocks
 	})
 
-	if len(feerates) > 5 {
-		// TODO: subject to confirmation! => persist top five fee rates only.
-		feerates = feerates[:5]
-	}
-
+	// All the fetched rates are cached as they are used to estimate
+	// confirmation targets, see EstimateConfirmations.
 	asset.fees.mu.Lock()
 	asset.fees.APIFeeRates = feerates
 	asset.fees.LastBestblock = asset.GetBestBlockHeight()
 	asset.fees.mu.Unlock()
 
-	return feerates, nil
+	return topFeeRates(feerates), nil
+}
+
+// FeeRate returns the fee rate in Sat/kvB estimated to get a tx confirmed
+// within confTarget blocks. Neutrino can't estimate fees so the estimate is
+// based on the fee estimates API, falling back to the user's configured fee
+// rate (see GetUserFeeRate) if the API can't be reached. It returns
+// utils.ErrChainClientNotStarted if the wallet hasn't started syncing.
+func (asset *Asset) FeeRate(confTarget int32) (satPerKb int64, err error) {
+	if asset.chainClient == nil {
+		return 0, utils.ErrChainClientNotStarted
+	}
+
+	if _, err := asset.GetAPIFeeEstimateRate(); err != nil {
+		log.Warnf("Using the configured fee rate: %v", err)
+		return asset.GetUserFeeRate().ToInt(), nil
+	}
+
+	asset.fees.mu.RLock()
+	feeRate, ok := feeRateForTarget(asset.fees.APIFeeRates, confTarget)
+	asset.fees.mu.RUnlock()
+	if !ok {
+		return asset.GetUserFeeRate().ToInt(), nil
+	}
+
+	minFeeRate := asset.GetMinFeeRate().ToInt()
+	if feeRate < minFeeRate {
+		feeRate = minFeeRate
+	}
+	return feeRate, nil
+}
+
+// feeRateForTarget returns the rate of the bucket with the lowest confirmation
+// target that is at least confTarget. If every bucket targets fewer blocks,
+// the rate of the slowest bucket is returned. The buckets must be sorted by
+// confirmation target.
+func feeRateForTarget(buckets []sharedW.FeeEstimate, confTarget int32) (int64, bool) {
+	var slowest *sharedW.FeeEstimate
+	for i := range buckets {
+		if buckets[i].Feerate == nil {
+			continue
+		}
+		if buckets[i].ConfirmedBlocks >= confTarget {
+			return buckets[i].Feerate.ToInt(), true
+		}
+		slowest = &buckets[i]
+	}
+	if slowest == nil {
+		return 0, false
+	}
+	return slowest.Feerate.ToInt(), true
+}
+
+// topFeeRates returns the fee rates with the five lowest confirmation targets.
+func topFeeRates(feerates []sharedW.FeeEstimate) []sharedW.FeeEstimate {
+	if len(feerates) > 5 {
+		// TODO: subject to confirmation! => display top five fee rates only.
+		return feerates[:5]
+	}
+	return feerates
+}
+
+// EstimateConfirmations estimates the number of blocks, and the time they
+// take to be mined, a tx paying the provided fee rate in kvB units will likely
+// need to be confirmed. The estimate is based on the fee rate buckets last
+// fetched from the fee estimates API (see GetAPIFeeEstimateRate), no network
+// request is made. known is false if no buckets have been fetched or if the
+// fee rate is lower than the rate of every bucket.
+func (asset *Asset) EstimateConfirmations(feeRatePerkvB sharedW.AssetAmount) (blocks int32, eta time.Duration, known bool) {
+	asset.fees.mu.RLock()
+	blocks, known = estimateConfirmations(asset.fees.APIFeeRates, feeRatePerkvB.ToInt())
+	asset.fees.mu.RUnlock()
+
+	if !known {
+		return 0, 0, false
+	}
+	return blocks, time.Duration(blocks) * asset.chainParams.TargetTimePerBlock, true
+}
+
+// estimateConfirmations returns the lowest confirmation target of the fee
+// rate buckets whose rate is covered by feeRate.
+func estimateConfirmations(buckets []sharedW.FeeEstimate, feeRate int64) (blocks int32, known bool) {
+	for _, bucket := range buckets {
+		if bucket.Feerate == nil || feeRate < bucket.Feerate.ToInt() {
+			continue
+		}
+		if !known || bucket.ConfirmedBlocks < blocks {
+			blocks, known = bucket.ConfirmedBlocks, true
+		}
+	}
+	return blocks, known
 }
 
 // SetUserFeeRate sets the fee rate in kvB units. Setting fee rate less than
-// MinFeeRatePerkvB is not allowed.
+// the wallet's minimum fee rate (see GetMinFeeRate) is not allowed.
 func (asset *Asset) SetUserFeeRate(feeRatePerkvB sharedW.AssetAmount) error {
+	minFeeRate := btcutil.Amount(asset.GetMinFeeRate().ToInt())
+
 	asset.fees.mu.Lock()
 	defer asset.fees.mu.Unlock()
 
-	if feeRatePerkvB.ToInt() < int64(MinFeeRatePerkvB) {
-		return fmt.Errorf("minimum rate is %d Sat/kvB", int64(MinFeeRatePerkvB))
+	if err := checkFeeRateFloor(btcutil.Amount(feeRatePerkvB.ToInt()), minFeeRate); err != nil {
+		return err
 	}
 
 	asset.fees.SetFeeRatePerkvB = feeRatePerkvB
@@ -172,3 +262,36 @@ func (asset *Asset) GetUserFeeRate() sharedW.AssetAmount {
 	}
 	return asset.fees.SetFeeRatePerkvB
 }
+
+// GetMinFeeRate returns the minimum fee rate in kvB units that transactions
+// built by this wallet may use. If not configured it defaults to
+// MinFeeRatePerkvB, the network relay minimum.
+func (asset *Asset) GetMinFeeRate() sharedW.AssetAmount {
+	minFeeRate := asset.ReadLongConfigValueForKey(sharedW.MinFeeRateConfigKey, int64(MinFeeRatePerkvB))
+	if minFeeRate < int64(MinFeeRatePerkvB) {
+		minFeeRate = int64(MinFeeRatePerkvB)
+	}
+	return Amount(minFeeRate)
+}
+
+// SetMinFeeRate sets the minimum fee rate in kvB units that transactions built
+// by this wallet may use. Setting a floor less than MinFeeRatePerkvB is not
+// allowed.
+func (asset *Asset) SetMinFeeRate(feeRatePerkvB sharedW.AssetAmount) error {
+	if err := checkFeeRateFloor(btcutil.Amount(feeRatePerkvB.ToInt()), MinFeeRatePerkvB); err != nil {
+		return err
+	}
+
+	asset.SetLongConfigValueForKey(sharedW.MinFeeRateConfigKey, feeRatePerkvB.ToInt())
+	return nil
+}
+
+// checkFeeRateFloor returns an error if the provided fee rate is below the
+// minimum fee rate allowed.
+func checkFeeRateFloor(feeRatePerkvB, minFeeRatePerkvB btcutil.Amount) error {
+	if feeRatePerkvB < minFeeRatePerkvB {
+		return fmt.Errorf("fee rate of %d Sat/kvB is below the minimum rate of %d Sat/kvB",
+			int64(feeRatePerkvB), int64(minFeeRatePerkvB))
+	}
+	return nil
+}
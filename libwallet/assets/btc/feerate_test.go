@@ -0,0 +1,225 @@
+package btc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/asdine/storm"
+	"github.com/btcsuite/btcd/btcutil"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestCheckFeeRateFloor(t *testing.T) {
+	tests := []struct {
+		name       string
+		feeRate    btcutil.Amount
+		minFeeRate btcutil.Amount
+		wantErr    bool
+	}{{
+		name:       "relay minimum",
+		feeRate:    MinFeeRatePerkvB,
+		minFeeRate: MinFeeRatePerkvB,
+	}, {
+		name:       "above floor",
+		feeRate:    FallBackFeeRatePerkvB,
+		minFeeRate: MinFeeRatePerkvB,
+	}, {
+		name:       "below relay minimum",
+		feeRate:    MinFeeRatePerkvB - 1,
+		minFeeRate: MinFeeRatePerkvB,
+		wantErr:    true,
+	}, {
+		name:       "below configured floor",
+		feeRate:    5000,
+		minFeeRate: 10000,
+		wantErr:    true,
+	}, {
+		name:       "zero rate",
+		minFeeRate: MinFeeRatePerkvB,
+		wantErr:    true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkFeeRateFloor(test.feeRate, test.minFeeRate)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEstimateConfirmations(t *testing.T) {
+	buckets := []sharedW.FeeEstimate{
+		{ConfirmedBlocks: 1, Feerate: Amount(30000)},
+		{ConfirmedBlocks: 3, Feerate: Amount(20000)},
+		{ConfirmedBlocks: 6, Feerate: Amount(10000)},
+		{ConfirmedBlocks: 144, Feerate: Amount(2000)},
+	}
+
+	tests := []struct {
+		name       string
+		buckets    []sharedW.FeeEstimate
+		feeRate    int64
+		wantBlocks int32
+		wantKnown  bool
+	}{{
+		name:      "no buckets",
+		feeRate:   50000,
+		wantKnown: false,
+	}, {
+		name:       "covers the fastest bucket",
+		buckets:    buckets,
+		feeRate:    50000,
+		wantBlocks: 1,
+		wantKnown:  true,
+	}, {
+		name:       "exact bucket rate",
+		buckets:    buckets,
+		feeRate:    20000,
+		wantBlocks: 3,
+		wantKnown:  true,
+	}, {
+		name:       "between buckets",
+		buckets:    buckets,
+		feeRate:    15000,
+		wantBlocks: 6,
+		wantKnown:  true,
+	}, {
+		name:       "slowest bucket",
+		buckets:    buckets,
+		feeRate:    2000,
+		wantBlocks: 144,
+		wantKnown:  true,
+	}, {
+		name:      "below every bucket",
+		buckets:   buckets,
+		feeRate:   1000,
+		wantKnown: false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			blocks, known := estimateConfirmations(test.buckets, test.feeRate)
+			if known != test.wantKnown {
+				t.Fatalf("expected known %v, got %v", test.wantKnown, known)
+			}
+			if blocks != test.wantBlocks {
+				t.Fatalf("expected %d blocks, got %d", test.wantBlocks, blocks)
+			}
+		})
+	}
+}
+
+func TestFeeRateForTarget(t *testing.T) {
+	buckets := []sharedW.FeeEstimate{
+		{ConfirmedBlocks: 1, Feerate: Amount(30000)},
+		{ConfirmedBlocks: 3, Feerate: Amount(20000)},
+		{ConfirmedBlocks: 6, Feerate: Amount(10000)},
+		{ConfirmedBlocks: 144, Feerate: Amount(2000)},
+	}
+
+	tests := []struct {
+		name        string
+		buckets     []sharedW.FeeEstimate
+		confTarget  int32
+		wantFeeRate int64
+		wantOK      bool
+	}{{
+		name:       "no buckets",
+		confTarget: 1,
+	}, {
+		name:        "exact target",
+		buckets:     buckets,
+		confTarget:  3,
+		wantFeeRate: 20000,
+		wantOK:      true,
+	}, {
+		name:        "between targets",
+		buckets:     buckets,
+		confTarget:  4,
+		wantFeeRate: 10000,
+		wantOK:      true,
+	}, {
+		name:        "beyond the slowest target",
+		buckets:     buckets,
+		confTarget:  1008,
+		wantFeeRate: 2000,
+		wantOK:      true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			feeRate, ok := feeRateForTarget(test.buckets, test.confTarget)
+			if ok != test.wantOK {
+				t.Fatalf("expected ok %v, got %v", test.wantOK, ok)
+			}
+			if feeRate != test.wantFeeRate {
+				t.Fatalf("expected fee rate %d, got %d", test.wantFeeRate, feeRate)
+			}
+		})
+	}
+}
+
+// testFeeRateAsset returns an asset whose config is persisted in a temporary
+// db, enough to read and write its fee rates.
+func testFeeRateAsset(t *testing.T) *Asset {
+	t.Helper()
+	rootDir := t.TempDir()
+	db, err := storm.Open(filepath.Join(rootDir, "wallets.db"))
+	if err != nil {
+		t.Fatalf("storm.Open error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	w := &sharedW.Wallet{ID: 1, Type: utils.BTCWalletAsset}
+	params := &sharedW.InitParams{RootDir: rootDir, NetType: utils.Testnet, DB: db}
+	dataDir := filepath.Join(rootDir, utils.NetDir(w.Type, params.NetType), w.Type.ToStringLower(), "1")
+	if err := os.MkdirAll(dataDir, utils.UserFilePerm); err != nil {
+		t.Fatalf("MkdirAll error: %v", err)
+	}
+	if err := w.Prepare(nil, params); err != nil {
+		t.Fatalf("Prepare error: %v", err)
+	}
+	t.Cleanup(func() { w.GetWalletDataDb().Close() })
+	return &Asset{Wallet: w}
+}
+
+func TestMinFeeRate(t *testing.T) {
+	asset := testFeeRateAsset(t)
+
+	if rate := asset.GetMinFeeRate().ToInt(); rate != int64(MinFeeRatePerkvB) {
+		t.Fatalf("expected the relay minimum %d by default, got %d", int64(MinFeeRatePerkvB), rate)
+	}
+	if err := asset.SetMinFeeRate(Amount(MinFeeRatePerkvB - 1)); err == nil {
+		t.Fatal("expected a floor below the relay minimum to be rejected")
+	}
+	if err := asset.SetUserFeeRate(Amount(5000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := asset.SetMinFeeRate(Amount(10000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate := asset.GetMinFeeRate().ToInt(); rate != 10000 {
+		t.Fatalf("expected a floor of 10000, got %d", rate)
+	}
+
+	if err := asset.SetUserFeeRate(Amount(9999)); err == nil {
+		t.Fatal("expected a fee rate below the floor to be rejected")
+	}
+	if rate := asset.GetUserFeeRate().ToInt(); rate != 5000 {
+		t.Fatalf("expected the rejected rate not to be applied, got %d", rate)
+	}
+
+	// The rate set before the floor was raised can't be used to build a tx.
+	if _, err := asset.constructTransaction(); err == nil || !strings.Contains(err.Error(), "below the minimum") {
+		t.Fatalf("expected a tx paying less than the floor to be rejected, got %v", err)
+	}
+
+	if err := asset.SetUserFeeRate(Amount(10000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
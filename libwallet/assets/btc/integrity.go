@@ -0,0 +1,209 @@
+package btc
+
+import (
+	"context"
+	"fmt"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/btcsuite/btcwallet/walletdb"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/lightninglabs/neutrino"
+)
+
+// VerifyIntegrity checks that the stored block headers form a continuous
+// chain, that the cfilter headers line up with the block headers and that the
+// block the wallet is synced to is part of the header chain. The wallet must
+// not be syncing while the check runs.
+func (asset *Asset) VerifyIntegrity(ctx context.Context, progress sharedW.IntegrityProgressFn) (*sharedW.IntegrityReport, error) {
+	cs, err := asset.integrityChainService()
+	if err != nil {
+		return nil, err
+	}
+
+	_, tip, err := cs.BlockHeaders.ChainTip()
+	if err != nil {
+		return nil, err
+	}
+	tipHeight := int32(tip)
+	report := &sharedW.IntegrityReport{CheckedHeight: tipHeight}
+
+	badHeight, err := sharedW.CheckHeaderContinuity(ctx, tipHeight, func(height int32) (hash, prevHash [32]byte, err error) {
+		header, err := cs.BlockHeaders.FetchHeaderByHeight(uint32(height))
+		if err != nil {
+			return hash, prevHash, err
+		}
+		return header.BlockHash(), header.PrevBlock, nil
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+	if badHeight >= 0 {
+		report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+			Kind:        sharedW.BlockHeadersIssue,
+			Height:      badHeight,
+			Description: fmt.Sprintf("block header chain is broken at height %d", badHeight),
+			// The genesis header can't be fetched again from peers.
+			Recoverable: badHeight > 0,
+		})
+		// Headers from the bad height onwards can't be trusted.
+		tipHeight = badHeight - 1
+	}
+
+	_, filterTip, err := cs.RegFilterHeaders.ChainTip()
+	switch {
+	case err != nil:
+		report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+			Kind:        sharedW.FilterHeadersIssue,
+			Description: fmt.Sprintf("cfilter headers can't be read: %v", err),
+		})
+	case int32(filterTip) > tipHeight:
+		report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+			Kind:        sharedW.FilterHeadersIssue,
+			Height:      tipHeight + 1,
+			Description: fmt.Sprintf("cfilter headers extend past the block headers to height %d", filterTip),
+			Recoverable: true,
+		})
+	default:
+		header, err := cs.BlockHeaders.FetchHeaderByHeight(filterTip)
+		if err == nil {
+			hash := header.BlockHash()
+			_, err = cs.RegFilterHeaders.FetchHeader(&hash)
+		}
+		if err != nil {
+			report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+				Kind:        sharedW.FilterHeadersIssue,
+				Height:      int32(filterTip),
+				Description: fmt.Sprintf("cfilter header at height %d doesn't match the block headers", filterTip),
+				Recoverable: filterTip > 0,
+			})
+		}
+	}
+
+	syncedTo := asset.Internal().BTC.Manager.SyncedTo()
+	if syncedTo.Height > 0 && syncedTo.Height <= tipHeight {
+		header, err := cs.BlockHeaders.FetchHeaderByHeight(uint32(syncedTo.Height))
+		if err != nil || header.BlockHash() != syncedTo.Hash {
+			report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+				Kind:        sharedW.WalletSyncIssue,
+				Height:      syncedTo.Height,
+				Description: fmt.Sprintf("wallet is synced to block %s which is not in the header chain", syncedTo.Hash),
+				Recoverable: true,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// RepairIntegrity fixes the recoverable issues found by VerifyIntegrity. The
+// block and cfilter headers are rolled back to below the first bad height so
+// they are fetched and indexed again on the next sync, and the wallet is set
+// to rescan from its birthday block. The wallet must not be syncing.
+func (asset *Asset) RepairIntegrity(ctx context.Context, report *sharedW.IntegrityReport, progress sharedW.IntegrityProgressFn) error {
+	if report == nil || !report.IsRecoverable() {
+		return errors.E(utils.ErrFailedPrecondition, "wallet integrity issues are not recoverable")
+	}
+
+	cs, err := asset.integrityChainService()
+	if err != nil {
+		return err
+	}
+
+	rollbackHeight := int32(-1)
+	for _, issue := range report.Issues {
+		if issue.Kind == sharedW.WalletSyncIssue {
+			continue
+		}
+		if height := issue.Height - 1; rollbackHeight == -1 || height < rollbackHeight {
+			rollbackHeight = height
+		}
+	}
+
+	if rollbackHeight >= 0 {
+		if err := rollbackHeaders(ctx, cs, uint32(rollbackHeight), progress); err != nil {
+			return err
+		}
+	}
+
+	// Any rolled back block may have held wallet transactions, rescan from
+	// the birthday block on the next sync.
+	wdb := asset.Internal().BTC.Database()
+	return walletdb.Update(wdb, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wAddrMgrBkt)
+		return asset.Internal().BTC.Manager.SetSyncedTo(ns, nil)
+	})
+}
+
+// integrityChainService returns the chain service whose header stores are
+// checked. The stores must not be written to while they are checked, so an
+// error is returned if the wallet is connected to the network.
+func (asset *Asset) integrityChainService() (*neutrino.ChainService, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	if asset.IsConnectedToBitcoinNetwork() {
+		return nil, errors.E(utils.ErrSyncAlreadyInProgress)
+	}
+
+	cs, ok := asset.chainClient.CS.(*neutrino.ChainService)
+	if !ok {
+		return nil, errors.E(utils.ErrUnavailable, "chain service not loaded")
+	}
+	return cs, nil
+}
+
+// rollbackHeaders removes the block and cfilter headers above height.
+func rollbackHeaders(ctx context.Context, cs *neutrino.ChainService, height uint32, progress sharedW.IntegrityProgressFn) error {
+	_, blockTip, err := cs.BlockHeaders.ChainTip()
+	if err != nil {
+		return err
+	}
+	_, filterTip, err := cs.RegFilterHeaders.ChainTip()
+	if err != nil {
+		return err
+	}
+
+	var total, done uint32
+	if blockTip > height {
+		total += blockTip - height
+	}
+	if filterTip > height {
+		total += filterTip - height
+	}
+	reportProgress := func() {
+		done++
+		if progress != nil {
+			progress(int32(done * 100 / total))
+		}
+	}
+
+	// The filter headers are rolled back first as each rollback requires the
+	// hash of the block that becomes the new filter tip.
+	for ; filterTip > height; filterTip-- {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		header, err := cs.BlockHeaders.FetchHeaderByHeight(filterTip - 1)
+		if err != nil {
+			return err
+		}
+		newTip := header.BlockHash()
+		if _, err := cs.RegFilterHeaders.RollbackLastBlock(&newTip); err != nil {
+			return err
+		}
+		reportProgress()
+	}
+
+	for ; blockTip > height; blockTip-- {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if _, err := cs.BlockHeaders.RollbackLastBlock(); err != nil {
+			return err
+		}
+		reportProgress()
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package btc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/lightninglabs/neutrino"
+)
+
+// PeerInfo describes a peer the neutrino chain service is connected to.
+type PeerInfo struct {
+	Address       string
+	Height        int32
+	TimeConnected time.Time
+}
+
+// peersTimeout is how long chainServicePeers waits for the chain service to
+// list its peers.
+var peersTimeout = 2 * time.Second
+
+// chainServicePeers returns the peers of the provided chain service.
+// *neutrino.ChainService.Peers() may stall, especially if the wallet hasn't
+// started sync yet. The method is called in a goroutine and no peers are
+// returned if it doesn't respond within peersTimeout.
+func chainServicePeers(cs ExtraNeutrinoChainService) []*neutrino.ServerPeer {
+	rawPeersChan := make(chan []*neutrino.ServerPeer, 1)
+	go func() {
+		rawPeersChan <- cs.Peers()
+	}()
+
+	select {
+	case rawPeers := <-rawPeersChan:
+		return rawPeers
+	case <-time.After(peersTimeout):
+		return nil // CS.Peers() is taking too long to respond
+	}
+}
+
+// chainService returns the neutrino chain service, or an error if the wallet
+// hasn't started syncing.
+func (asset *Asset) chainService() (ExtraNeutrinoChainService, error) {
+	if asset.chainClient == nil || asset.chainClient.CS == nil {
+		return nil, utils.ErrChainClientNotStarted
+	}
+	return asset.chainClient.CS.(ExtraNeutrinoChainService), nil
+}
+
+// Peers returns the peers the wallet is currently connected to. No peers are
+// returned if the wallet hasn't started syncing.
+func (asset *Asset) Peers() []PeerInfo {
+	cs, err := asset.chainService()
+	if err != nil {
+		return nil
+	}
+
+	serverPeers := chainServicePeers(cs)
+	peers := make([]PeerInfo, 0, len(serverPeers))
+	for _, p := range serverPeers {
+		peers = append(peers, PeerInfo{
+			Address:       p.Addr(),
+			Height:        p.LastBlock(),
+			TimeConnected: p.TimeConnected(),
+		})
+	}
+	return peers
+}
+
+// AddPeer connects the wallet to the peer at addr, using the network's
+// default port if none is provided. The peer isn't persisted, it is dropped
+// when the wallet stops syncing.
+func (asset *Asset) AddPeer(addr string) error {
+	cs, err := asset.chainService()
+	if err != nil {
+		return err
+	}
+
+	peerAddress, err := asset.normalizePeerAddress(addr)
+	if err != nil {
+		return err
+	}
+	return cs.ConnectNode(peerAddress, false)
+}
+
+// RemovePeer disconnects the wallet from the peer at addr, using the
+// network's default port if none is provided.
+func (asset *Asset) RemovePeer(addr string) error {
+	cs, err := asset.chainService()
+	if err != nil {
+		return err
+	}
+
+	peerAddress, err := asset.normalizePeerAddress(addr)
+	if err != nil {
+		return err
+	}
+	return cs.RemoveNodeByAddr(peerAddress)
+}
+
+// AdditionalPeers returns the peers the wallet connects to in addition to
+// the peers discovered on the network when it starts syncing.
+func (asset *Asset) AdditionalPeers() []string {
+	peerAddresses := asset.ReadStringConfigValueForKey(sharedW.SpvAdditionalPeerAddressesConfigKey, "")
+	peers, errs := sharedW.ParseWalletPeers(peerAddresses, asset.chainParams.DefaultPort)
+	for _, err := range errs {
+		log.Error(err)
+	}
+	return peers
+}
+
+// SetAdditionalPeers saves the peers the wallet connects to in addition to
+// the peers discovered on the network. If the wallet is syncing, it connects
+// to the new peers and disconnects from the removed ones.
+func (asset *Asset) SetAdditionalPeers(addrs []string) error {
+	peers := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		peerAddress, err := asset.normalizePeerAddress(addr)
+		if err != nil {
+			return err
+		}
+		peers = append(peers, peerAddress)
+	}
+
+	previousPeers := asset.AdditionalPeers()
+	asset.SetStringConfigValueForKey(sharedW.SpvAdditionalPeerAddressesConfigKey, strings.Join(peers, ";"))
+
+	cs, err := asset.chainService()
+	if err != nil || !asset.IsConnectedToBitcoinNetwork() {
+		// The peers are added when the wallet starts syncing.
+		return nil
+	}
+
+	added, removed := diffPeers(previousPeers, peers)
+	for _, peerAddress := range removed {
+		if err := cs.RemoveNodeByAddr(peerAddress); err != nil {
+			log.Warnf("Unable to disconnect from peer %s: %v", peerAddress, err)
+		}
+	}
+	for _, peerAddress := range added {
+		if err := cs.ConnectNode(peerAddress, true); err != nil {
+			return fmt.Errorf("unable to connect to peer %s: %v", peerAddress, err)
+		}
+	}
+	return nil
+}
+
+// diffPeers returns the peers in next that aren't in previous and the peers
+// in previous that aren't in next.
+func diffPeers(previous, next []string) (added, removed []string) {
+	inPrevious := make(map[string]bool, len(previous))
+	for _, peer := range previous {
+		inPrevious[peer] = true
+	}
+	inNext := make(map[string]bool, len(next))
+	for _, peer := range next {
+		inNext[peer] = true
+		if !inPrevious[peer] {
+			added = append(added, peer)
+		}
+	}
+	for _, peer := range previous {
+		if !inNext[peer] {
+			removed = append(removed, peer)
+		}
+	}
+	return added, removed
+}
+
+func (asset *Asset) normalizePeerAddress(addr string) (string, error) {
+	peers, errs := sharedW.ParseWalletPeers(addr, asset.chainParams.DefaultPort)
+	if len(errs) > 0 {
+		return "", errs[0]
+	}
+	if len(peers) != 1 {
+		return "", fmt.Errorf("invalid peer address %q", addr)
+	}
+	return peers[0], nil
+}
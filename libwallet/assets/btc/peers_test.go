@@ -0,0 +1,156 @@
+package btc
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/lightninglabs/neutrino"
+)
+
+func TestDiffPeers(t *testing.T) {
+	tests := []struct {
+		name                   string
+		previous, next         []string
+		wantAdded, wantRemoved []string
+	}{{
+		name:      "no previous peers",
+		next:      []string{"127.0.0.1:8333"},
+		wantAdded: []string{"127.0.0.1:8333"},
+	}, {
+		name:        "all peers removed",
+		previous:    []string{"127.0.0.1:8333"},
+		wantRemoved: []string{"127.0.0.1:8333"},
+	}, {
+		name:        "peer replaced",
+		previous:    []string{"127.0.0.1:8333", "127.0.0.2:8333"},
+		next:        []string{"127.0.0.2:8333", "127.0.0.3:8333"},
+		wantAdded:   []string{"127.0.0.3:8333"},
+		wantRemoved: []string{"127.0.0.1:8333"},
+	}, {
+		name:     "unchanged",
+		previous: []string{"127.0.0.1:8333"},
+		next:     []string{"127.0.0.1:8333"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			added, removed := diffPeers(test.previous, test.next)
+			if !reflect.DeepEqual(added, test.wantAdded) {
+				t.Fatalf("expected added peers %v, got %v", test.wantAdded, added)
+			}
+			if !reflect.DeepEqual(removed, test.wantRemoved) {
+				t.Fatalf("expected removed peers %v, got %v", test.wantRemoved, removed)
+			}
+		})
+	}
+}
+
+// testChainService records the peers the wallet connects to and disconnects
+// from. Peers blocks until unblockPeers is closed, if set.
+type testChainService struct {
+	chain.NeutrinoChainService
+
+	peers        []*neutrino.ServerPeer
+	unblockPeers chan struct{}
+	connected    []string
+	removed      []string
+}
+
+func (cs *testChainService) ConnectedCount() int32 {
+	return int32(len(cs.peers))
+}
+
+func (cs *testChainService) Peers() []*neutrino.ServerPeer {
+	if cs.unblockPeers != nil {
+		<-cs.unblockPeers
+	}
+	return cs.peers
+}
+
+func (cs *testChainService) ConnectNode(addr string, _ bool) error {
+	cs.connected = append(cs.connected, addr)
+	return nil
+}
+
+func (cs *testChainService) RemoveNodeByAddr(addr string) error {
+	cs.removed = append(cs.removed, addr)
+	return nil
+}
+
+func testPeersAsset(cs *testChainService) *Asset {
+	asset := &Asset{chainParams: &chaincfg.TestNet3Params}
+	if cs != nil {
+		asset.chainClient = &chain.NeutrinoClient{CS: cs}
+	}
+	return asset
+}
+
+func TestAddRemovePeer(t *testing.T) {
+	if err := testPeersAsset(nil).AddPeer("127.0.0.1"); !errors.Is(err, utils.ErrChainClientNotStarted) {
+		t.Fatalf("expected %v before syncing, got %v", utils.ErrChainClientNotStarted, err)
+	}
+
+	cs := new(testChainService)
+	asset := testPeersAsset(cs)
+
+	if err := asset.AddPeer("127.0.0.1"); err != nil {
+		t.Fatalf("AddPeer error: %v", err)
+	}
+	if err := asset.AddPeer("127.0.0.2:8333"); err != nil {
+		t.Fatalf("AddPeer error: %v", err)
+	}
+	// The network's default port is used if none is provided.
+	wantConnected := []string{"127.0.0.1:18333", "127.0.0.2:8333"}
+	if !reflect.DeepEqual(cs.connected, wantConnected) {
+		t.Fatalf("expected connections to %v, got %v", wantConnected, cs.connected)
+	}
+
+	if err := asset.RemovePeer("127.0.0.1"); err != nil {
+		t.Fatalf("RemovePeer error: %v", err)
+	}
+	if want := []string{"127.0.0.1:18333"}; !reflect.DeepEqual(cs.removed, want) {
+		t.Fatalf("expected %v to be disconnected, got %v", want, cs.removed)
+	}
+
+	if err := asset.AddPeer("127.0.0.1;127.0.0.2"); err == nil {
+		t.Fatal("expected an error adding an invalid peer address")
+	}
+	if len(cs.connected) != len(wantConnected) {
+		t.Fatalf("expected the invalid peer not to be connected, got %v", cs.connected)
+	}
+}
+
+func TestPeers(t *testing.T) {
+	if peers := testPeersAsset(nil).Peers(); peers != nil {
+		t.Fatalf("expected no peers before syncing, got %v", peers)
+	}
+
+	p, err := peer.NewOutboundPeer(&peer.Config{ChainParams: &chaincfg.TestNet3Params}, "127.0.0.1:18333")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := &testChainService{peers: []*neutrino.ServerPeer{{Peer: p}}}
+
+	peers := testPeersAsset(cs).Peers()
+	if len(peers) != 1 || peers[0].Address != "127.0.0.1:18333" {
+		t.Fatalf("expected peer 127.0.0.1:18333, got %v", peers)
+	}
+
+	defer func(timeout time.Duration) {
+		peersTimeout = timeout
+	}(peersTimeout)
+	peersTimeout = 10 * time.Millisecond
+
+	// No peers are returned if the chain service stalls.
+	cs.unblockPeers = make(chan struct{})
+	defer close(cs.unblockPeers)
+	if peers := testPeersAsset(cs).Peers(); len(peers) != 0 {
+		t.Fatalf("expected no peers from a stalled chain service, got %v", peers)
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	"decred.org/dcrwallet/v4/errors"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	w "github.com/btcsuite/btcwallet/wallet"
 	"github.com/btcsuite/btcwallet/walletdb"
@@ -25,11 +27,38 @@ func (asset *Asset) RescanBlocks() error {
 }
 
 // RescanBlocksFromHeight rescans the blockchain for all addresses in the wallet
-// starting from the provided block height.
+// starting from the provided block height, which must be below the best block.
+// Progress is reported to the blocks rescan progress listener.
 func (asset *Asset) RescanBlocksFromHeight(startHeight int32) error {
+	if err := checkRescanStartHeight(startHeight, asset.GetBestBlockHeight()); err != nil {
+		return err
+	}
 	return asset.rescanBlocks(startHeight, nil)
 }
 
+// checkRescanStartHeight returns an error if a rescan can't start at
+// startHeight given the current best block height.
+func checkRescanStartHeight(startHeight, bestHeight int32) error {
+	if startHeight < 0 || startHeight >= bestHeight {
+		return fmt.Errorf("rescan start height %d must be between 0 and %d", startHeight, bestHeight-1)
+	}
+	return nil
+}
+
+// activeAddresses returns the addresses of the wallet that the chain is
+// watched for.
+func (asset *Asset) activeAddresses() ([]btcutil.Address, error) {
+	var addrs []btcutil.Address
+	err := walletdb.View(asset.Internal().BTC.Database(), func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wAddrMgrBkt)
+		return asset.Internal().BTC.Manager.ForEachActiveAddress(ns, func(addr btcutil.Address) error {
+			addrs = append(addrs, addr)
+			return nil
+		})
+	})
+	return addrs, err
+}
+
 func (asset *Asset) rescanBlocks(startHeight int32, addrs []btcutil.Address) error {
 	if !asset.IsConnectedToBitcoinNetwork() {
 		return errors.E(utils.ErrNotConnected)
@@ -53,7 +82,10 @@ func (asset *Asset) rescanBlocks(startHeight int32, addrs []btcutil.Address) err
 	}
 
 	if addrs == nil {
-		addrs = []btcutil.Address{}
+		addrs, err = asset.activeAddresses()
+		if err != nil {
+			return fmt.Errorf("unable to read the wallet addresses: %v", err)
+		}
 	}
 
 	asset.syncData.mu.Lock()
@@ -161,6 +193,80 @@ func (asset *Asset) forceRescan() {
 	asset.handleSyncUIUpdate()
 }
 
+// blockSource looks up the blocks of the chain. It is implemented by the
+// neutrino chain client.
+type blockSource interface {
+	GetBlockHash(height int64) (*chainhash.Hash, error)
+	GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader, error)
+}
+
+// birthdayBlockStamp returns the block before birthdayHeight. btcwallet starts
+// the recovery of a wallet at the block after the one it is synced to, setting
+// it as the synced to block makes the initial rescan start at birthdayHeight.
+func birthdayBlockStamp(chain blockSource, birthdayHeight int32) (*waddrmgr.BlockStamp, error) {
+	height := birthdayHeight - 1
+	hash, err := chain.GetBlockHash(int64(height))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the hash of block %d: %w", height, err)
+	}
+
+	header, err := chain.GetBlockHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the header of block %d: %w", height, err)
+	}
+
+	return &waddrmgr.BlockStamp{
+		Hash:      *hash,
+		Height:    height,
+		Timestamp: header.Timestamp,
+	}, nil
+}
+
+// pendingBirthdayHeight returns the birthday height the wallet was imported
+// with if its initial rescan hasn't been done yet, 0 otherwise.
+func (asset *Asset) pendingBirthdayHeight() int32 {
+	if !asset.IsRestored || asset.ContainsDiscoveredAccounts() {
+		return 0
+	}
+	return asset.ReadInt32ConfigValueForKey(sharedW.BirthdayHeightConfigKey, 0)
+}
+
+// applyBirthdayHeight waits for the headers up to the birthday height to be
+// synced, the block before it is only known then, and sets that block as the
+// birthday and synced to block of the wallet.
+func (asset *Asset) applyBirthdayHeight(birthdayHeight int32) error {
+	t := time.NewTicker(time.Second)
+	defer t.Stop()
+	for {
+		block, err := asset.chainClient.CS.BestBlock()
+		if err == nil && block.Height >= birthdayHeight {
+			break
+		}
+		if err == nil && asset.chainClient.IsCurrent() {
+			return fmt.Errorf("the birthday height is above the best block %d", block.Height)
+		}
+
+		select {
+		case <-asset.syncCtx.Done():
+			return asset.syncCtx.Err()
+		case <-t.C:
+		}
+	}
+
+	bs, err := birthdayBlockStamp(asset.chainClient, birthdayHeight)
+	if err != nil {
+		return err
+	}
+
+	return walletdb.Update(asset.Internal().BTC.Database(), func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wAddrMgrBkt)
+		if err := asset.Internal().BTC.Manager.SetSyncedTo(ns, bs); err != nil {
+			return err
+		}
+		return asset.Internal().BTC.Manager.SetBirthdayBlock(ns, *bs, true)
+	})
+}
+
 // updateAssetBirthday updates the appropriate birthday and birthday block
 // immediately after initial rescan is completed.
 func (asset *Asset) updateAssetBirthday() {
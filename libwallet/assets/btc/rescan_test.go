@@ -0,0 +1,78 @@
+package btc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+func TestCheckRescanStartHeight(t *testing.T) {
+	tests := []struct {
+		name        string
+		startHeight int32
+		bestHeight  int32
+		wantErr     bool
+	}{
+		{name: "genesis", startHeight: 0, bestHeight: 100},
+		{name: "below best block", startHeight: 99, bestHeight: 100},
+		{name: "at best block", startHeight: 100, bestHeight: 100, wantErr: true},
+		{name: "above best block", startHeight: 150, bestHeight: 100, wantErr: true},
+		{name: "negative", startHeight: -1, bestHeight: 100, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkRescanStartHeight(test.startHeight, test.bestHeight)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+// testBlockSource is a chain of blocks whose hashes are their heights.
+type testBlockSource struct {
+	bestHeight int32
+}
+
+func (s testBlockSource) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	if height < 0 || height > int64(s.bestHeight) {
+		return nil, fmt.Errorf("no block at height %d", height)
+	}
+	return &chainhash.Hash{byte(height), byte(height >> 8), byte(height >> 16)}, nil
+}
+
+func (s testBlockSource) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader, error) {
+	height := int64(hash[0]) | int64(hash[1])<<8 | int64(hash[2])<<16
+	return &wire.BlockHeader{Timestamp: time.Unix(height*600, 0)}, nil
+}
+
+func TestBirthdayBlockStamp(t *testing.T) {
+	chain := testBlockSource{bestHeight: 1000}
+
+	for _, birthdayHeight := range []int32{1, 500, 1000} {
+		bs, err := birthdayBlockStamp(chain, birthdayHeight)
+		if err != nil {
+			t.Fatalf("birthday height %d: unexpected error: %v", birthdayHeight, err)
+		}
+
+		// The rescan starts at the block after the synced to block.
+		if rescanStart := bs.Height + 1; rescanStart != birthdayHeight {
+			t.Fatalf("expected the rescan to start at %d, got %d", birthdayHeight, rescanStart)
+		}
+		wantHash, _ := chain.GetBlockHash(int64(bs.Height))
+		if bs.Hash != *wantHash {
+			t.Fatalf("birthday height %d: expected hash %v, got %v", birthdayHeight, wantHash, bs.Hash)
+		}
+		if want := time.Unix(int64(bs.Height)*600, 0); !bs.Timestamp.Equal(want) {
+			t.Fatalf("birthday height %d: expected timestamp %v, got %v", birthdayHeight, want, bs.Timestamp)
+		}
+	}
+
+	if _, err := birthdayBlockStamp(chain, 1002); err == nil {
+		t.Fatal("expected an error for a birthday height above the synced headers")
+	}
+}
@@ -49,7 +49,8 @@ type SyncData struct {
 	wg sync.WaitGroup
 
 	// Listeners
-	syncProgressListeners map[string]*sharedW.SyncProgressListener
+	syncProgressListeners   map[string]*sharedW.SyncProgressListener
+	syncProgressSubscribers map[string]chan SyncProgress
 
 	*activeSyncData
 }
@@ -317,6 +318,7 @@ func (asset *Asset) loadChainService() (chainService *neutrino.ChainService, err
 		ChainParams:   *asset.chainParams,
 		PersistToDisk: true, // keep cfilter headers on disk for efficient rescanning
 		ConnectPeers:  validPeerAddresses,
+		AddPeers:      asset.AdditionalPeers(),
 		// Dialer function helps to better control the dialer functionality.
 		Dialer: utils.DialerFunc(asset.dailerCtx),
 		// WARNING: PublishTransaction currently uses the entire duration
@@ -454,6 +456,22 @@ func (asset *Asset) startSync() error {
 	}
 
 	log.Infof("Synchronizing wallet (%s) with network...", asset.GetWalletName())
+	if height := asset.pendingBirthdayHeight(); height > 0 {
+		// The initial rescan of a wallet imported with a birthday height
+		// starts once the block at that height is known.
+		go func() {
+			err := asset.applyBirthdayHeight(height)
+			if asset.syncCtx.Err() != nil {
+				return
+			}
+			if err != nil {
+				log.Errorf("unable to start the rescan at the birthday height %d, rescanning from the genesis block: %v", height, err)
+			}
+			asset.Internal().BTC.SynchronizeRPC(asset.chainClient)
+		}()
+		return nil
+	}
+
 	// Initializes the goroutines handling chain notifications, rescan progress and handlers.
 	asset.Internal().BTC.SynchronizeRPC(asset.chainClient)
 
@@ -478,6 +496,9 @@ func (asset *Asset) startWallet() (err error) {
 	if asset.IsRestored && !asset.ContainsDiscoveredAccounts() {
 		asset.forceRescan()
 	}
+
+	go asset.publishSyncProgress()
+
 	// Initiate the sync protocol and return an error incase of failure.
 	return asset.startSync()
 }
@@ -555,13 +576,12 @@ func (asset *Asset) SpvSync() (err error) {
 	}
 
 	go func() {
-		err = asset.startWallet()
-		if err != nil {
+		if err := asset.startWallet(); err != nil {
 			log.Warn("error occurred when starting BTC sync: ", err)
 		}
 	}()
 
-	return err
+	return nil
 }
 
 // reloadChainService loads a new instance of chain service to be used
@@ -0,0 +1,133 @@
+package btc
+
+import (
+	"time"
+
+	"decred.org/dcrwallet/v4/errors"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// syncProgressInterval is how often the sync progress is published to the
+// subscribed channels.
+const syncProgressInterval = 2 * time.Second
+
+// SyncProgress is a snapshot of the progress of the wallet sync.
+type SyncProgress struct {
+	WalletID       int
+	HeadersFetched int32   // Height of the best header in the header store.
+	TargetHeight   int32   // Best block height reported by the connected peers.
+	SyncedPercent  float64 // Share of the target height the wallet is synced to.
+}
+
+// GetSyncProgress returns the height of the best header fetched, the best
+// block height reported by the connected peers and the percentage of the
+// chain the wallet is synced to.
+func (asset *Asset) GetSyncProgress() (headersFetched int32, targetHeight int32, syncedPercent float64) {
+	if !asset.WalletOpened() || asset.chainClient == nil {
+		return 0, 0, 0
+	}
+
+	block, err := asset.chainClient.CS.BestBlock()
+	if err != nil {
+		log.Errorf("reading the best header failed: %v", err)
+		return 0, 0, 0
+	}
+	headersFetched = block.Height
+
+	asset.syncData.mu.RLock()
+	targetHeight = asset.syncData.bestBlockheight
+	asset.syncData.mu.RUnlock()
+	if targetHeight < headersFetched {
+		// The peers haven't been queried yet or they lag behind.
+		targetHeight = headersFetched
+	}
+
+	syncedTo := asset.Internal().BTC.Manager.SyncedTo()
+	return headersFetched, targetHeight, percentSynced(syncedTo.Height, targetHeight)
+}
+
+// percentSynced returns the share of targetHeight that syncedHeight
+// represents, capped to 100.
+func percentSynced(syncedHeight, targetHeight int32) float64 {
+	if targetHeight <= 0 || syncedHeight <= 0 {
+		return 0
+	}
+	if syncedHeight >= targetHeight {
+		return 100
+	}
+	return float64(syncedHeight) * 100 / float64(targetHeight)
+}
+
+// SubscribeSyncProgress returns a channel the sync progress is published on
+// while the wallet syncs. Only the latest progress is kept if the receiver
+// falls behind. The channel is closed by UnsubscribeSyncProgress.
+func (asset *Asset) SubscribeSyncProgress(uniqueIdentifier string) (<-chan SyncProgress, error) {
+	asset.syncData.mu.Lock()
+	defer asset.syncData.mu.Unlock()
+
+	if _, ok := asset.syncData.syncProgressSubscribers[uniqueIdentifier]; ok {
+		return nil, errors.New(utils.ErrListenerAlreadyExist)
+	}
+
+	if asset.syncData.syncProgressSubscribers == nil {
+		asset.syncData.syncProgressSubscribers = make(map[string]chan SyncProgress)
+	}
+	progress := make(chan SyncProgress, 1)
+	asset.syncData.syncProgressSubscribers[uniqueIdentifier] = progress
+	return progress, nil
+}
+
+// UnsubscribeSyncProgress closes and removes the channel returned by
+// SubscribeSyncProgress for uniqueIdentifier.
+func (asset *Asset) UnsubscribeSyncProgress(uniqueIdentifier string) {
+	asset.syncData.mu.Lock()
+	defer asset.syncData.mu.Unlock()
+
+	if progress, ok := asset.syncData.syncProgressSubscribers[uniqueIdentifier]; ok {
+		close(progress)
+		delete(asset.syncData.syncProgressSubscribers, uniqueIdentifier)
+	}
+}
+
+// publishSyncProgress periodically publishes the sync progress to the
+// subscribed channels until the wallet is synced or the sync is canceled.
+func (asset *Asset) publishSyncProgress() {
+	t := time.NewTicker(syncProgressInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-t.C:
+			headersFetched, targetHeight, syncedPercent := asset.GetSyncProgress()
+			asset.sendSyncProgress(SyncProgress{
+				WalletID:       asset.ID,
+				HeadersFetched: headersFetched,
+				TargetHeight:   targetHeight,
+				SyncedPercent:  syncedPercent,
+			})
+
+			if asset.IsSynced() {
+				return
+			}
+		case <-asset.syncCtx.Done():
+			return
+		}
+	}
+}
+
+func (asset *Asset) sendSyncProgress(progress SyncProgress) {
+	asset.syncData.mu.RLock()
+	defer asset.syncData.mu.RUnlock()
+
+	for _, subscriber := range asset.syncData.syncProgressSubscribers {
+		// Drop the progress the receiver hasn't read yet in favour of this one.
+		select {
+		case <-subscriber:
+		default:
+		}
+		select {
+		case subscriber <- progress:
+		default:
+		}
+	}
+}
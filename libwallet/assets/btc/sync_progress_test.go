@@ -0,0 +1,76 @@
+package btc
+
+import "testing"
+
+func TestPercentSynced(t *testing.T) {
+	tests := []struct {
+		name         string
+		syncedHeight int32
+		targetHeight int32
+		want         float64
+	}{{
+		name:         "unknown target",
+		syncedHeight: 10,
+	}, {
+		name:         "not started",
+		targetHeight: 200,
+	}, {
+		name:         "halfway",
+		syncedHeight: 100,
+		targetHeight: 200,
+		want:         50,
+	}, {
+		name:         "synced",
+		syncedHeight: 200,
+		targetHeight: 200,
+		want:         100,
+	}, {
+		name:         "past target",
+		syncedHeight: 201,
+		targetHeight: 200,
+		want:         100,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := percentSynced(test.syncedHeight, test.targetHeight); got != test.want {
+				t.Fatalf("expected %v%%, got %v%%", test.want, got)
+			}
+		})
+	}
+}
+
+func TestSubscribeSyncProgress(t *testing.T) {
+	asset := &Asset{syncData: new(SyncData)}
+
+	progress, err := asset.SubscribeSyncProgress("test")
+	if err != nil {
+		t.Fatalf("SubscribeSyncProgress error: %v", err)
+	}
+	if _, err := asset.SubscribeSyncProgress("test"); err == nil {
+		t.Fatal("expected an error subscribing twice with the same identifier")
+	}
+
+	// Only the latest progress is kept for a receiver that falls behind.
+	asset.sendSyncProgress(SyncProgress{WalletID: 1, SyncedPercent: 10})
+	asset.sendSyncProgress(SyncProgress{WalletID: 1, SyncedPercent: 20})
+	select {
+	case p := <-progress:
+		if p.SyncedPercent != 20 {
+			t.Fatalf("expected the latest progress of 20%%, got %v%%", p.SyncedPercent)
+		}
+	default:
+		t.Fatal("expected the progress to be published")
+	}
+
+	asset.UnsubscribeSyncProgress("test")
+	if _, ok := <-progress; ok {
+		t.Fatal("expected the channel to be closed once unsubscribed")
+	}
+	// Publishing without subscribers doesn't block.
+	asset.sendSyncProgress(SyncProgress{WalletID: 1, SyncedPercent: 30})
+
+	if _, err := asset.SubscribeSyncProgress("test"); err != nil {
+		t.Fatalf("expected the identifier to be reusable once unsubscribed, got %v", err)
+	}
+}
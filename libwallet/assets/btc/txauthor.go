@@ -10,6 +10,7 @@ import (
 
 	"decred.org/dcrwallet/v4/errors"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -33,6 +34,9 @@ type TxAuthor struct {
 	inputValues       []btcutil.Amount
 	txSpendAmount     btcutil.Amount // Equal to fee + send amount
 	changeDestination *sharedW.TransactionDestination
+	// freshChange requires the change to go to an unused address that is
+	// neither a recipient nor an input address.
+	freshChange bool
 
 	unsignedTx     *txauthor.AuthoredTx
 	needsConstruct bool
@@ -61,10 +65,22 @@ func (asset *Asset) NewUnsignedTx(sourceAccountNumber int32, utxos []*sharedW.Un
 		destinations:        make(map[int]*sharedW.TransactionDestination, 0),
 		needsConstruct:      true,
 		selectedUXTOs:       utxos,
+		freshChange:         true,
 	}
 	return nil
 }
 
+// SetFreshChangeAddress sets whether the change of the transaction must go to
+// an unused address that is neither a recipient nor an input address. This
+// is enabled by default.
+func (asset *Asset) SetFreshChangeAddress(fresh bool) {
+	asset.TxAuthoredInfo.mu.Lock()
+	defer asset.TxAuthoredInfo.mu.Unlock()
+
+	asset.TxAuthoredInfo.freshChange = fresh
+	asset.TxAuthoredInfo.needsConstruct = true
+}
+
 // GetUnsignedTx returns the unsigned transaction.
 func (asset *Asset) GetUnsignedTx() *TxAuthor {
 	return asset.TxAuthoredInfo
@@ -372,6 +388,132 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	return txHash.String(), utils.TranslateError(err)
 }
 
+// CreateUnsignedTransaction returns the transaction authored with
+// NewUnsignedTx and AddSendDestination as a serialized PSBT, so it can be
+// signed offline by a wallet that holds the private keys. The previous output
+// of every input is included for the signer.
+func (asset *Asset) CreateUnsignedTransaction() ([]byte, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrBTCNotInitialized
+	}
+
+	if asset.TxAuthoredInfo == nil {
+		return nil, fmt.Errorf("TxAuthoredInfo is nil")
+	}
+
+	asset.TxAuthoredInfo.mu.Lock()
+	defer asset.TxAuthoredInfo.mu.Unlock()
+
+	unsignedTx, err := asset.unsignedTransaction()
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+
+	if unsignedTx.ChangeIndex > 0 {
+		unsignedTx.RandomizeChangePosition()
+	}
+
+	msgTx := unsignedTx.Tx.Copy()
+	// LockTime is set as it is when the transaction is signed and broadcast
+	// by this wallet, to discourage fee sniping.
+	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
+
+	packet, err := psbt.NewFromUnsignedTx(msgTx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range packet.Inputs {
+		prevOutAmount := int64(asset.TxAuthoredInfo.inputValues[i])
+		packet.Inputs[i].WitnessUtxo = wire.NewTxOut(prevOutAmount, unsignedTx.PrevScripts[i])
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	// The exported tx is persisted so its signed version can be imported
+	// even after the send form is edited or the app is restarted.
+	if err := asset.SaveExportedTx(exportedTx(msgTx)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportSignedTransaction broadcasts a transaction that was exported with
+// CreateUnsignedTransaction and signed offline, and returns its hash. data is
+// either a PSBT with all its inputs signed or a serialized raw transaction.
+// Transactions that don't spend the inputs of an exported unsigned transaction
+// to its outputs are rejected.
+func (asset *Asset) ImportSignedTransaction(data []byte) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrBTCNotInitialized
+	}
+
+	msgTx, err := decodeSignedTx(data)
+	if err != nil {
+		return "", err
+	}
+
+	exported, err := asset.FindExportedTx(exportedTx(msgTx))
+	if err != nil {
+		return "", err
+	}
+
+	if err := asset.Internal().BTC.PublishTransaction(msgTx, ""); err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	if err := asset.RemoveExportedTx(exported.Hash); err != nil {
+		log.Errorf("removing the exported tx %s failed: %v", exported.Hash, err)
+	}
+	return msgTx.TxHash().String(), nil
+}
+
+// decodeSignedTx decodes a signed PSBT, finalizing it if needed, or a raw
+// transaction.
+func decodeSignedTx(data []byte) (*wire.MsgTx, error) {
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(data), false)
+	if err == nil {
+		if err := psbt.MaybeFinalizeAll(packet); err != nil {
+			return nil, fmt.Errorf("finalizing the psbt failed: %w", err)
+		}
+		return psbt.Extract(packet)
+	}
+
+	msgTx := new(wire.MsgTx)
+	if err := msgTx.Deserialize(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("decoding the signed transaction failed: %w", err)
+	}
+	return msgTx, nil
+}
+
+// exportedTx returns the inputs and outputs of tx as they are persisted for
+// the unsigned transactions exported with CreateUnsignedTransaction.
+func exportedTx(tx *wire.MsgTx) *sharedW.ExportedTx {
+	return &sharedW.ExportedTx{
+		Hash:    tx.TxHash().String(),
+		Inputs:  txInputs(tx),
+		Outputs: txOutputs(tx),
+	}
+}
+
+func txInputs(tx *wire.MsgTx) []string {
+	inputs := make([]string, 0, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		inputs = append(inputs, txIn.PreviousOutPoint.String())
+	}
+	return inputs
+}
+
+func txOutputs(tx *wire.MsgTx) []string {
+	outputs := make([]string, 0, len(tx.TxOut))
+	for _, txOut := range tx.TxOut {
+		outputs = append(outputs, fmt.Sprintf("%d:%x", txOut.Value, txOut.PkScript))
+	}
+	return outputs
+}
+
 func (asset *Asset) unsignedTransaction() (*txauthor.AuthoredTx, error) {
 	if asset.TxAuthoredInfo.needsConstruct || asset.TxAuthoredInfo.unsignedTx == nil {
 		unsignedTx, err := asset.constructTransaction()
@@ -393,6 +535,12 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 	setFeeRate := btcutil.Amount(asset.GetUserFeeRate().ToInt())
 	var sendMax bool
 
+	// Transactions paying less than the configured minimum fee rate risk
+	// never being confirmed, reject them before building anything.
+	if err = checkFeeRateFloor(setFeeRate, btcutil.Amount(asset.GetMinFeeRate().ToInt())); err != nil {
+		return nil, err
+	}
+
 	for _, destination := range asset.TxAuthoredInfo.destinations {
 		if err := asset.validateSendAmount(destination.SendMax, destination.UnitAmount); err != nil {
 			return nil, err
@@ -427,6 +575,15 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		}
 	}
 
+	// if preset with a selected list of UTXOs exists, use them instead.
+	unspents := asset.TxAuthoredInfo.selectedUXTOs
+	if len(unspents) == 0 {
+		unspents, err = asset.UnspentOutputs(int32(asset.TxAuthoredInfo.sourceAccountNumber))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Case activated when sendMax is false.
 	if changeSource == nil {
 		// btcwallet should ordinarily handle cases where a nil changeSource
@@ -436,16 +593,7 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		//
 		// Generating a changeSource manually here, ensures that the gap address
 		// limit exhaustion error is avoided.
-		changeSource, err = asset.changeSource()
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// if preset with a selected list of UTXOs exists, use them instead.
-	unspents := asset.TxAuthoredInfo.selectedUXTOs
-	if len(unspents) == 0 {
-		unspents, err = asset.UnspentOutputs(int32(asset.TxAuthoredInfo.sourceAccountNumber))
+		changeSource, err = asset.changeSource(unspents)
 		if err != nil {
 			return nil, err
 		}
@@ -475,14 +623,35 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 // for this unsigned tx, if a change address had not been previously derived.
 // The derived (or previously derived) address is used to prepare a
 // change source for receiving change from this tx back into the sharedW.
-func (asset *Asset) changeSource() (*txauthor.ChangeSource, error) {
-	if asset.TxAuthoredInfo.changeAddress == "" {
+// If fresh change is required, the address is also checked against the
+// recipients and the utxos that may be spent.
+func (asset *Asset) changeSource(utxos []*sharedW.UnspentOutput) (*txauthor.ChangeSource, error) {
+	var avoid map[string]bool
+	if asset.TxAuthoredInfo.freshChange {
+		avoid = sharedW.ChangeAddressesToAvoid(asset.TxAuthoredInfo.destinations, utxos)
+	}
+
+	if asset.TxAuthoredInfo.changeAddress == "" || avoid[asset.TxAuthoredInfo.changeAddress] {
 		changeAccount := asset.TxAuthoredInfo.sourceAccountNumber
-		address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, GetScope())
+		next := func() (string, error) {
+			address, err := asset.Internal().BTC.NewChangeAddress(changeAccount, GetScope())
+			if err != nil {
+				return "", err
+			}
+			return address.String(), nil
+		}
+
+		var address string
+		var err error
+		if asset.TxAuthoredInfo.freshChange {
+			address, err = sharedW.PickChangeAddress(next, asset.isAddressUsed, avoid)
+		} else {
+			address, err = next()
+		}
 		if err != nil {
 			return nil, fmt.Errorf("change address error: %v", err)
 		}
-		asset.TxAuthoredInfo.changeAddress = address.String()
+		asset.TxAuthoredInfo.changeAddress = address
 	}
 
 	changeSource, err := txhelper.MakeBTCTxChangeSource(asset.TxAuthoredInfo.changeAddress, asset.chainParams)
@@ -0,0 +1,105 @@
+package btc
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func testUnsignedTx() *wire.MsgTx {
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 1), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x00, 0x14, 0x01}))
+	tx.AddTxOut(wire.NewTxOut(2000, []byte{0x00, 0x14, 0x02}))
+	return tx
+}
+
+func TestMatchExportedTx(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(tx *wire.MsgTx)
+		wantErr bool
+	}{{
+		name: "signed",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxIn[0].Witness = wire.TxWitness{{0x01}}
+			tx.TxIn[1].Witness = wire.TxWitness{{0x02}}
+		},
+	}, {
+		name: "reordered",
+		modify: func(tx *wire.MsgTx) {
+			tx.TxIn[0], tx.TxIn[1] = tx.TxIn[1], tx.TxIn[0]
+			tx.TxOut[0], tx.TxOut[1] = tx.TxOut[1], tx.TxOut[0]
+		},
+	}, {
+		name:    "different amount",
+		modify:  func(tx *wire.MsgTx) { tx.TxOut[0].Value = 1500 },
+		wantErr: true,
+	}, {
+		name:    "different address",
+		modify:  func(tx *wire.MsgTx) { tx.TxOut[1].PkScript = []byte{0x00, 0x14, 0x03} },
+		wantErr: true,
+	}, {
+		name:    "different input",
+		modify:  func(tx *wire.MsgTx) { tx.TxIn[1].PreviousOutPoint.Index = 2 },
+		wantErr: true,
+	}, {
+		name:    "extra output",
+		modify:  func(tx *wire.MsgTx) { tx.AddTxOut(wire.NewTxOut(10, []byte{0x00, 0x14, 0x04})) },
+		wantErr: true,
+	}, {
+		name:    "duplicate input",
+		modify:  func(tx *wire.MsgTx) { tx.TxIn[1].PreviousOutPoint = tx.TxIn[0].PreviousOutPoint },
+		wantErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signed := testUnsignedTx()
+			test.modify(signed)
+			err := exportedTx(testUnsignedTx()).Match(exportedTx(signed))
+			if test.wantErr != errors.Is(err, utils.ErrSignedTxMismatch) {
+				t.Fatalf("expected mismatch: %v, got: %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDecodeSignedTx(t *testing.T) {
+	unsigned := testUnsignedTx()
+
+	var raw bytes.Buffer
+	if err := unsigned.Serialize(&raw); err != nil {
+		t.Fatal(err)
+	}
+	tx, err := decodeSignedTx(raw.Bytes())
+	if err != nil {
+		t.Fatalf("decoding a raw transaction failed: %v", err)
+	}
+	if tx.TxHash() != unsigned.TxHash() {
+		t.Fatalf("expected tx %s, got %s", unsigned.TxHash(), tx.TxHash())
+	}
+
+	// A PSBT whose inputs aren't signed can't be finalized.
+	packet, err := psbt.NewFromUnsignedTx(unsigned)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeSignedTx(b.Bytes()); err == nil {
+		t.Fatal("expected an error decoding an unsigned psbt")
+	}
+
+	if _, err := decodeSignedTx([]byte{0x01, 0x02}); err == nil {
+		t.Fatal("expected an error decoding garbage")
+	}
+}
@@ -38,4 +38,6 @@ type ExtraNeutrinoChainService interface {
 
 	ConnectedCount() int32
 	Peers() []*neutrino.ServerPeer
+	ConnectNode(addr string, permanent bool) error
+	RemoveNodeByAddr(addr string) error
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"fmt"
 	"path/filepath"
 	"sync"
 	"time"
@@ -140,7 +141,11 @@ func initWalletLoader(chainParams *chaincfg.Params, dbDirPath string) loader.Ass
 // shared wallet implementation.
 // Immediately a watch only wallet is created, the function to safely cancel network sync
 // is set. There after returning the watch only wallet's interface.
-func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW.InitParams) (sharedW.Asset, error) {
+func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW.InitParams, birthdayHeight int32) (sharedW.Asset, error) {
+	if birthdayHeight < 0 {
+		return nil, fmt.Errorf("invalid birthday height %d", birthdayHeight)
+	}
+
 	chainParams, err := utils.BTCChainParams(params.NetType)
 	if err != nil {
 		return nil, err
@@ -166,6 +171,10 @@ func CreateWatchOnlyWallet(walletName, extendedPublicKey string, params *sharedW
 		return nil, err
 	}
 
+	if birthdayHeight > 0 {
+		btcWallet.SetInt32ConfigValueForKey(sharedW.BirthdayHeightConfigKey, birthdayHeight)
+	}
+
 	btcWallet.SetNetworkCancelCallback(btcWallet.SafelyCancelSync)
 
 	return btcWallet, nil
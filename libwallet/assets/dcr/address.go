@@ -1,10 +1,12 @@
 package dcr
 
 import (
+	"context"
 	"fmt"
 
 	"decred.org/dcrwallet/v4/errors"
 	w "decred.org/dcrwallet/v4/wallet"
+	"decred.org/dcrwallet/v4/wallet/udb"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 )
@@ -141,3 +143,40 @@ func (asset *Asset) AddressPubKey(address string) (string, error) {
 		return "", fmt.Errorf("address is not a managed pub key address")
 	}
 }
+
+// isAddressUsed returns true if the provided address was derived at or below
+// the last used index of its account branch.
+func (asset *Asset) isAddressUsed(ctx context.Context, address string) bool {
+	addr, err := stdaddr.DecodeAddress(address, asset.chainParams)
+	if err != nil {
+		return false
+	}
+
+	knownAddr, err := asset.Internal().DCR.KnownAddress(ctx, addr)
+	if err != nil {
+		return false
+	}
+	bip44Addr, ok := knownAddr.(w.BIP0044Address)
+	if !ok {
+		return false
+	}
+	account, branch, child := bip44Addr.Path()
+
+	accounts, err := asset.Internal().DCR.Accounts(ctx)
+	if err != nil {
+		log.Errorf("failed to check if address %s is used: %v", address, err)
+		return false
+	}
+	for _, acct := range accounts.Accounts {
+		if acct.AccountNumber != account {
+			continue
+		}
+		lastUsed := acct.LastUsedExternalIndex
+		if branch == udb.InternalBranch {
+			lastUsed = acct.LastUsedInternalIndex
+		}
+		// No address in the branch has been used if lastUsed is ^uint32(0).
+		return lastUsed != ^uint32(0) && child <= lastUsed
+	}
+	return false
+}
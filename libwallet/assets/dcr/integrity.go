@@ -0,0 +1,61 @@
+package dcr
+
+import (
+	"context"
+	"fmt"
+
+	"decred.org/dcrwallet/v4/errors"
+	w "decred.org/dcrwallet/v4/wallet"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/decred/dcrd/wire"
+)
+
+// VerifyIntegrity checks that the block headers stored in the wallet database
+// form a continuous main chain. The wallet must not be syncing while the
+// check runs. Broken header chains are reported as unrecoverable as the
+// wallet database can't be partially rolled back; the wallet has to be
+// restored instead.
+func (asset *Asset) VerifyIntegrity(ctx context.Context, progress sharedW.IntegrityProgressFn) (*sharedW.IntegrityReport, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	if asset.IsConnectedToDecredNetwork() {
+		return nil, errors.E(utils.ErrSyncAlreadyInProgress)
+	}
+
+	dcrWallet := asset.Internal().DCR
+	_, tipHeight := dcrWallet.MainChainTip(ctx)
+	report := &sharedW.IntegrityReport{CheckedHeight: tipHeight}
+
+	badHeight, err := sharedW.CheckHeaderContinuity(ctx, tipHeight, func(height int32) (hash, prevHash [32]byte, err error) {
+		info, err := dcrWallet.BlockInfo(ctx, w.NewBlockIdentifierFromHeight(height))
+		if err != nil {
+			return hash, prevHash, err
+		}
+		var header wire.BlockHeader
+		if err := header.FromBytes(info.Header); err != nil {
+			return hash, prevHash, err
+		}
+		return info.Hash, header.PrevBlock, nil
+	}, progress)
+	if err != nil {
+		return nil, err
+	}
+	if badHeight >= 0 {
+		report.Issues = append(report.Issues, sharedW.IntegrityIssue{
+			Kind:        sharedW.BlockHeadersIssue,
+			Height:      badHeight,
+			Description: fmt.Sprintf("block header chain is broken at height %d", badHeight),
+		})
+	}
+
+	return report, nil
+}
+
+// RepairIntegrity is not supported for DCR wallets as none of the issues
+// reported by VerifyIntegrity are recoverable.
+func (asset *Asset) RepairIntegrity(_ context.Context, _ *sharedW.IntegrityReport, _ sharedW.IntegrityProgressFn) error {
+	return errors.E(utils.ErrFailedPrecondition, "wallet integrity issues are not recoverable")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"runtime/trace"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"decred.org/dcrwallet/v4/errors"
@@ -224,6 +225,27 @@ func (asset *Asset) VSPTicketInfo(hash string) (*VSPTicketInfo, error) {
 	return ticketInfo, nil
 }
 
+// TicketVSPHost returns the host of the VSP the ticket was purchased with, as
+// recorded in the wallet db. An empty host is returned if the ticket wasn't
+// purchased with a VSP.
+func (asset *Asset) TicketVSPHost(hash string) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrDCRNotInitialized
+	}
+
+	ticketHash, err := chainhash.NewHashFromStr(hash)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	host, err := asset.Internal().DCR.VSPHostForTicket(ctx, ticketHash)
+	if errors.Is(err, errors.NotExist) {
+		return "", nil
+	}
+	return host, err
+}
+
 // StartTicketBuyer starts the automatic ticket buyer. The wallet
 // should already be configured with the required parameters using
 // asset.SetAutoTicketsBuyerConfig().
@@ -245,6 +267,9 @@ func (asset *Asset) StartTicketBuyer(passphrase string) error {
 	if cfg.BalanceToMaintain < 0 {
 		return errors.New("Negative balance to maintain in ticket buyer config")
 	}
+	if cfg.MaxTicketsPerWindow < 0 {
+		return errors.New("Negative maximum tickets per window in ticket buyer config")
+	}
 
 	if asset.IsAutoTicketsPurchaseActive() {
 		return errors.New("Ticket buyer already running")
@@ -315,6 +340,10 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 
 	var nextIntervalStart, expiry int32
 	var cancels []func()
+	// windowPurchases counts the purchases started in the current ticket
+	// price window that haven't failed. A new counter is used for each
+	// window so that purchases from an earlier window don't affect it.
+	windowPurchases := new(int32)
 	for {
 		select {
 		case <-ctx.Done():
@@ -361,6 +390,7 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 					cancels[i] = nil
 				}
 				cancels = cancels[:0]
+				windowPurchases = new(int32)
 
 				intervalSize := int32(w.ChainParams().StakeDiffWindowSize)
 				currentInterval := height / intervalSize
@@ -409,11 +439,21 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 				continue
 			}
 
+			buy = ticketsWithinLimit(buy, cfg.MaxTicketsPerWindow, atomic.LoadInt32(windowPurchases))
+			if buy == 0 {
+				log.Debugf("[%d] Skipping purchase: ticket limit for the price window reached", asset.ID)
+				continue
+			}
+			purchases := windowPurchases
+			atomic.AddInt32(purchases, int32(buy))
+
 			cancelCtx, cancel := context.WithCancel(ctx)
 			cancels = append(cancels, cancel)
 			buyTicket := func() {
 				err := asset.buyTicket(cancelCtx, passphrase, sdiff, expiry, cfg)
 				if err != nil {
+					// Failed purchases don't count towards the window limit.
+					atomic.AddInt32(purchases, -1)
 					switch {
 					// silence these errors
 					case errors.Is(err, errors.InsufficientBalance):
@@ -440,6 +480,24 @@ func (asset *Asset) runTicketBuyer(ctx context.Context, passphrase string, cfg *
 	}
 }
 
+// ticketsWithinLimit caps the number of tickets to buy so that no more than
+// maxPerWindow tickets are purchased in a ticket price window, given that
+// purchased tickets were already bought in it. A maxPerWindow of zero means
+// there is no limit.
+func ticketsWithinLimit(buy int, maxPerWindow, purchased int32) int {
+	if maxPerWindow <= 0 {
+		return buy
+	}
+	remaining := int(maxPerWindow - purchased)
+	if remaining <= 0 {
+		return 0
+	}
+	if buy > remaining {
+		return remaining
+	}
+	return buy
+}
+
 // buyTicket purchases one ticket with the asset.
 func (asset *Asset) buyTicket(ctx context.Context, passphrase string, sdiff dcrutil.Amount, expiry int32, cfg *TicketBuyerConfig) error {
 	ctx, task := trace.NewTask(ctx, "ticketbuyer.buy")
@@ -521,8 +579,11 @@ func (asset *Asset) StopAutoTicketsPurchase() error {
 }
 
 // SetAutoTicketsBuyerConfig sets ticket buyer config for the asset.
-func (asset *Asset) SetAutoTicketsBuyerConfig(vspHost string, purchaseAccount int32, amountToMaintain int64) {
+// A maxTicketsPerWindow of zero doesn't limit the tickets purchased in a
+// ticket price window.
+func (asset *Asset) SetAutoTicketsBuyerConfig(vspHost string, purchaseAccount int32, amountToMaintain int64, maxTicketsPerWindow int32) {
 	asset.SetLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, amountToMaintain)
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsConfigKey, maxTicketsPerWindow)
 	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, purchaseAccount)
 	asset.SetStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, vspHost)
 }
@@ -533,11 +594,13 @@ func (asset *Asset) AutoTicketsBuyerConfig() *TicketBuyerConfig {
 	btm := asset.ReadLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, -1)
 	accNum := asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, -1)
 	vspHost := asset.ReadStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "")
+	maxTickets := asset.ReadInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsConfigKey, 0)
 
 	return &TicketBuyerConfig{
-		VspHost:           vspHost,
-		PurchaseAccount:   accNum,
-		BalanceToMaintain: btm,
+		VspHost:             vspHost,
+		PurchaseAccount:     accNum,
+		BalanceToMaintain:   btm,
+		MaxTicketsPerWindow: maxTickets,
 	}
 }
 
@@ -556,6 +619,7 @@ func (asset *Asset) ClearTicketBuyerConfig(_ int) error {
 	asset.SetLongConfigValueForKey(sharedW.TicketBuyerATMConfigKey, -1)
 	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerAccountConfigKey, -1)
 	asset.SetStringConfigValueForKey(sharedW.TicketBuyerVSPHostConfigKey, "")
+	asset.SetInt32ConfigValueForKey(sharedW.TicketBuyerMaxTicketsConfigKey, 0)
 
 	return nil
 }
@@ -0,0 +1,25 @@
+package dcr
+
+import "testing"
+
+func TestTicketsWithinLimit(t *testing.T) {
+	tests := []struct {
+		name         string
+		buy          int
+		maxPerWindow int32
+		purchased    int32
+		want         int
+	}{
+		{"no limit", 5, 0, 3, 5},
+		{"zero purchased", 3, 5, 0, 3},
+		{"zero purchased over the limit", 8, 5, 0, 5},
+		{"below the limit", 4, 5, 2, 3},
+		{"at the limit", 2, 5, 5, 0},
+		{"over the limit", 2, 5, 7, 0},
+	}
+	for _, test := range tests {
+		if got := ticketsWithinLimit(test.buy, test.maxPerWindow, test.purchased); got != test.want {
+			t.Errorf("%s: expected %d, got %d", test.name, test.want, got)
+		}
+	}
+}
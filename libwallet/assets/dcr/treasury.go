@@ -3,8 +3,11 @@ package dcr
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	"decred.org/dcrwallet/v4/errors"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 
 	"github.com/decred/dcrd/blockchain/stake/v5"
@@ -12,6 +15,9 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
+// maxTreasuryPolicyHistory is the number of policy changes kept per PI key.
+const maxTreasuryPolicyHistory = 10
+
 // SetTreasuryPolicy saves the voting policy for treasury spends by a particular
 // PI key.
 // If a ticket hash is provided, the voting policy is also updated with the VSP
@@ -140,9 +146,58 @@ func (asset *Asset) SetTreasuryPolicy(PiKey, newVotingPolicy, tixHash string, pa
 	}
 
 	vspPreferenceUpdateSuccess = firstErr == nil
+	if vspPreferenceUpdateSuccess && ticketHash == nil {
+		asset.recordTreasuryPolicyChange(PiKey, policy)
+	}
 	return firstErr
 }
 
+// recordTreasuryPolicyChange adds the policy set for the PI key to the
+// history returned by TreasuryPolicyHistory.
+func (asset *Asset) recordTreasuryPolicyChange(piKey string, policy stake.TreasuryVoteT) {
+	history := make(map[string][]TreasuryPolicyChange)
+	_ = asset.ReadUserConfigValue(sharedW.TreasuryPolicyHistoryConfigKey, &history)
+
+	piKey = strings.ToLower(piKey)
+	changes := append(history[piKey], TreasuryPolicyChange{
+		Policy:    treasuryPolicyString(policy),
+		Height:    asset.GetBestBlockHeight(),
+		Timestamp: time.Now().Unix(),
+	})
+	if len(changes) > maxTreasuryPolicyHistory {
+		changes = changes[len(changes)-maxTreasuryPolicyHistory:]
+	}
+	history[piKey] = changes
+
+	asset.SaveUserConfigValue(sharedW.TreasuryPolicyHistoryConfigKey, history)
+}
+
+// TreasuryPolicyHistory returns the voting policies previously set for the PI
+// key from this wallet, most recent first. Policies set for specific tickets
+// aren't included.
+func (asset *Asset) TreasuryPolicyHistory(piKey string) []TreasuryPolicyChange {
+	history := make(map[string][]TreasuryPolicyChange)
+	_ = asset.ReadUserConfigValue(sharedW.TreasuryPolicyHistoryConfigKey, &history)
+
+	changes := history[strings.ToLower(piKey)]
+	mostRecentFirst := make([]TreasuryPolicyChange, len(changes))
+	for i, change := range changes {
+		mostRecentFirst[len(changes)-1-i] = change
+	}
+	return mostRecentFirst
+}
+
+func treasuryPolicyString(policy stake.TreasuryVoteT) string {
+	switch policy {
+	case stake.TreasuryVoteYes:
+		return "yes"
+	case stake.TreasuryVoteNo:
+		return "no"
+	default:
+		return "abstain"
+	}
+}
+
 // TreasuryPolicies returns saved voting policies for treasury spends
 // per pi key. If a pi key is specified, the policy for that pi key
 // is returned; otherwise the policies for all pi keys are returned.
@@ -167,20 +222,11 @@ func (asset *Asset) TreasuryPolicies(PiKey, tixHash string) ([]*TreasuryKeyPolic
 		if err != nil {
 			return nil, fmt.Errorf("invalid pikey: %w", err)
 		}
-		var policy string
-		switch asset.Internal().DCR.TreasuryKeyPolicy(pikey, ticketHash) {
-		case stake.TreasuryVoteYes:
-			policy = "yes"
-		case stake.TreasuryVoteNo:
-			policy = "no"
-		default:
-			policy = "abstain"
-		}
 		res := []*TreasuryKeyPolicy{
 			{
 				TicketHash: tixHash,
 				PiKey:      PiKey,
-				Policy:     policy,
+				Policy:     treasuryPolicyString(asset.Internal().DCR.TreasuryKeyPolicy(pikey, ticketHash)),
 			},
 		}
 		return res, nil
@@ -27,6 +27,9 @@ type TxAuthor struct {
 	destinations        map[int]*sharedW.TransactionDestination
 	changeAddress       string
 	changeDestination   *sharedW.TransactionDestination
+	// freshChange requires the change to go to an unused address that is
+	// neither a recipient nor an input address.
+	freshChange bool
 
 	utxos          []*sharedW.UnspentOutput
 	unsignedTx     *txauthor.AuthoredTx
@@ -44,10 +47,19 @@ func (asset *Asset) NewUnsignedTx(sourceAccountNumber int32, utxos []*sharedW.Un
 		destinations:        make(map[int]*sharedW.TransactionDestination, 0),
 		needsConstruct:      true,
 		utxos:               utxos,
+		freshChange:         true,
 	}
 	return nil
 }
 
+// SetFreshChangeAddress sets whether the change of the transaction must go to
+// an unused address that is neither a recipient nor an input address. This
+// is enabled by default.
+func (asset *Asset) SetFreshChangeAddress(fresh bool) {
+	asset.TxAuthoredInfo.freshChange = fresh
+	asset.TxAuthoredInfo.needsConstruct = true
+}
+
 // ComputeTxSizeEstimation computes the estimated size of the final raw transaction.
 func (asset *Asset) ComputeTxSizeEstimation(dstnAddress string, utxos []*sharedW.UnspentOutput) (int, error) {
 	if len(utxos) == 0 {
@@ -291,14 +303,112 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	return txHash.String(), asset.updateTxLabel(txHash, transactionLabel)
 }
 
-// updateTxLabel saves the tx label in the local instance.
+// CreateUnsignedTransaction returns the serialized raw transaction authored
+// with NewUnsignedTx and AddSendDestination without signing it, so it can be
+// signed offline by a wallet that holds the private keys.
+func (asset *Asset) CreateUnsignedTransaction() ([]byte, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrDCRNotInitialized
+	}
+
+	if asset.TxAuthoredInfo == nil {
+		return nil, fmt.Errorf("TxAuthoredInfo is nil")
+	}
+
+	unsignedTx, err := asset.unsignedTransaction()
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+
+	if unsignedTx.ChangeIndex >= 0 {
+		unsignedTx.RandomizeChangePosition()
+	}
+
+	// The exported tx is persisted so its signed version can be imported
+	// even after the send form is edited or the app is restarted.
+	if err := asset.SaveExportedTx(exportedTx(unsignedTx.Tx)); err != nil {
+		return nil, err
+	}
+	return unsignedTx.Tx.Bytes()
+}
+
+// ImportSignedTransaction broadcasts a raw transaction that was exported with
+// CreateUnsignedTransaction and signed offline, and returns its hash.
+// Transactions that don't spend the inputs of an exported unsigned transaction
+// to its outputs are rejected.
+func (asset *Asset) ImportSignedTransaction(data []byte) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrDCRNotInitialized
+	}
+
+	var msgTx wire.MsgTx
+	if err := msgTx.Deserialize(bytes.NewReader(data)); err != nil {
+		return "", fmt.Errorf("decoding the signed transaction failed: %w", err)
+	}
+
+	exported, err := asset.FindExportedTx(exportedTx(&msgTx))
+	if err != nil {
+		return "", err
+	}
+
+	n, err := asset.Internal().DCR.NetworkBackend()
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+
+	ctx, _ := asset.ShutdownContextWithCancel()
+	txHash, err := asset.Internal().DCR.PublishTransaction(ctx, &msgTx, n)
+	if err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	if err := asset.RemoveExportedTx(exported.Hash); err != nil {
+		log.Errorf("removing the exported tx %s failed: %v", exported.Hash, err)
+	}
+	return txHash.String(), nil
+}
+
+// exportedTx returns the inputs and outputs of tx as they are persisted for
+// the unsigned transactions exported with CreateUnsignedTransaction.
+func exportedTx(tx *wire.MsgTx) *sharedW.ExportedTx {
+	return &sharedW.ExportedTx{
+		Hash:    tx.TxHash().String(),
+		Inputs:  txInputs(tx),
+		Outputs: txOutputs(tx),
+	}
+}
+
+func txInputs(tx *wire.MsgTx) []string {
+	inputs := make([]string, 0, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		inputs = append(inputs, fmt.Sprintf("%s:%d", txIn.PreviousOutPoint.String(), txIn.PreviousOutPoint.Tree))
+	}
+	return inputs
+}
+
+func txOutputs(tx *wire.MsgTx) []string {
+	outputs := make([]string, 0, len(tx.TxOut))
+	for _, txOut := range tx.TxOut {
+		outputs = append(outputs, fmt.Sprintf("%d:%d:%x", txOut.Value, txOut.Version, txOut.PkScript))
+	}
+	return outputs
+}
+
+// updateTxLabel saves the tx label in the local instance. The label is also
+// kept in the wallet config so it isn't lost if the tx index is rebuilt.
 func (asset *Asset) updateTxLabel(hash *chainhash.Hash, txLabel string) error {
 	tx := &sharedW.Transaction{
 		Hash:  hash.String(),
 		Label: txLabel,
 	}
-	_, err := asset.GetWalletDataDb().SaveOrUpdate(&sharedW.Transaction{}, tx)
-	return err
+	if _, err := asset.GetWalletDataDb().SaveOrUpdate(&sharedW.Transaction{}, tx); err != nil {
+		return err
+	}
+	if txLabel == "" {
+		return nil
+	}
+	return asset.SetTxLabel(tx.Hash, txLabel)
 }
 
 func (asset *Asset) unsignedTransaction() (*txauthor.AuthoredTx, error) {
@@ -355,6 +465,15 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		}
 	}
 
+	// if preset with a selected list of UTXOs exists, use them instead.
+	unspents := asset.TxAuthoredInfo.utxos
+	if len(unspents) == 0 {
+		unspents, err = asset.UnspentOutputs(int32(asset.TxAuthoredInfo.sourceAccountNumber))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if changeSource == nil {
 		// dcrwallet should ordinarily handle cases where a nil changeSource
 		// is passed to `sharedW.NewUnsignedTransaction` but the changeSource
@@ -363,16 +482,7 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 		//
 		// Generating a changeSource manually here, ensures that the gap address
 		// limit exhaustion error is avoided.
-		changeSource, err = asset.changeSource(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	// if preset with a selected list of UTXOs exists, use them instead.
-	unspents := asset.TxAuthoredInfo.utxos
-	if len(unspents) == 0 {
-		unspents, err = asset.UnspentOutputs(int32(asset.TxAuthoredInfo.sourceAccountNumber))
+		changeSource, err = asset.changeSource(ctx, unspents)
 		if err != nil {
 			return nil, err
 		}
@@ -480,8 +590,15 @@ func (asset *Asset) makeInputSource(sendMax bool, utxos []*sharedW.UnspentOutput
 // for this unsigned tx, if a change address had not been previously derived.
 // The derived (or previously derived) address is used to prepare a
 // change source for receiving change from this tx back into the sharedW.
-func (asset *Asset) changeSource(ctx context.Context) (txauthor.ChangeSource, error) {
-	if asset.TxAuthoredInfo.changeAddress == "" {
+// If fresh change is required, the address is also checked against the
+// recipients and the utxos that may be spent.
+func (asset *Asset) changeSource(ctx context.Context, utxos []*sharedW.UnspentOutput) (txauthor.ChangeSource, error) {
+	var avoid map[string]bool
+	if asset.TxAuthoredInfo.freshChange {
+		avoid = sharedW.ChangeAddressesToAvoid(asset.TxAuthoredInfo.destinations, utxos)
+	}
+
+	if asset.TxAuthoredInfo.changeAddress == "" || avoid[asset.TxAuthoredInfo.changeAddress] {
 		var changeAccount uint32
 
 		// MixedAccountNumber would be -1 if mixer config isn't set.
@@ -492,11 +609,27 @@ func (asset *Asset) changeSource(ctx context.Context) (txauthor.ChangeSource, er
 			changeAccount = asset.TxAuthoredInfo.sourceAccountNumber
 		}
 
-		address, err := asset.Internal().DCR.NewChangeAddress(ctx, changeAccount)
+		next := func() (string, error) {
+			address, err := asset.Internal().DCR.NewChangeAddress(ctx, changeAccount)
+			if err != nil {
+				return "", err
+			}
+			return address.String(), nil
+		}
+
+		var address string
+		var err error
+		if asset.TxAuthoredInfo.freshChange {
+			address, err = sharedW.PickChangeAddress(next, func(address string) bool {
+				return asset.isAddressUsed(ctx, address)
+			}, avoid)
+		} else {
+			address, err = next()
+		}
 		if err != nil {
 			return nil, fmt.Errorf("change address error: %v", err)
 		}
-		asset.TxAuthoredInfo.changeAddress = address.String()
+		asset.TxAuthoredInfo.changeAddress = address
 	}
 
 	changeSource, err := txhelper.MakeTxChangeSource(asset.TxAuthoredInfo.changeAddress, asset.chainParams)
@@ -90,6 +90,9 @@ type TicketBuyerConfig struct {
 	VspHost           string
 	PurchaseAccount   int32
 	BalanceToMaintain int64
+	// MaxTicketsPerWindow is the maximum number of tickets purchased within
+	// a ticket price window. Zero means no limit.
+	MaxTicketsPerWindow int32
 
 	VspClient *vsp.Client
 }
@@ -155,6 +158,13 @@ type VSP struct {
 	*vspd.VspInfoResponse
 }
 
+// VSPStatus is the reachability of a VSP as of the last time it was checked.
+type VSPStatus struct {
+	Online    bool
+	LastSeen  int64 // Unix time of the last successful response, 0 if never.
+	CheckedAt int64 // Unix time of the last check.
+}
+
 /** end vspd-related types */
 
 /** begin agenda types */
@@ -199,3 +209,10 @@ type TreasuryKeyPolicy struct {
 	TicketHash string `json:"ticket_hash"` // nil unless for per-ticket VSP policies
 	Policy     string `json:"policy"`
 }
+
+// TreasuryPolicyChange records a voting policy set for a PI key.
+type TreasuryPolicyChange struct {
+	Policy    string `json:"policy"`
+	Height    int32  `json:"height"`    // Best block height when the policy was set.
+	Timestamp int64  `json:"timestamp"` // Unix time the policy was set.
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"decred.org/dcrwallet/v4/vsp"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
@@ -17,6 +18,14 @@ import (
 
 const (
 	defaultVSPsURL = "https://api.decred.org/?c=vsp"
+
+	// vspStatusTTL is how long the checked status of a VSP is reused for
+	// before the VSP is queried again.
+	vspStatusTTL = 10 * time.Minute
+
+	// vspListTTL is how long the list of known VSPs is used before
+	// LoadVSPList reloads it.
+	vspListTTL = 30 * time.Minute
 )
 
 // VSPClient loads or creates a VSP client instance for the specified host.
@@ -71,9 +80,7 @@ func (asset *Asset) createVspClient(account int32, host string, pubKey []byte) (
 // KnownVSPs returns a list of known VSPs. This list may be updated by calling
 // ReloadVSPList. This method is safe for concurrent access.
 func (asset *Asset) KnownVSPs() []*VSP {
-	asset.vspMu.RLock()
-	defer asset.vspMu.RUnlock()
-	return asset.vsps
+	return asset.knownVSPs.list()
 }
 
 // SaveVSP marks a VSP as known and will be susbequently included as part of
@@ -101,9 +108,8 @@ func (asset *Asset) SaveVSP(host string) (err error) {
 	vspDbData.SavedHosts = append(vspDbData.SavedHosts, host)
 	asset.updateVSPDBData(vspDbData)
 
-	asset.vspMu.Lock()
-	asset.vsps = append(asset.vsps, &VSP{Host: host, VspInfoResponse: info})
-	asset.vspMu.Unlock()
+	asset.knownVSPs.add(&VSP{Host: host, VspInfoResponse: info})
+	asset.setVSPStatus(host, true)
 
 	return
 }
@@ -136,10 +142,32 @@ func (asset *Asset) updateVSPDBData(data *vspDbData) {
 	asset.SaveUserConfigValue(sharedW.KnownVSPsConfigKey, data)
 }
 
-// ReloadVSPList reloads the list of known VSPs.
-// This method makes multiple network calls; should be called in a goroutine
-// to prevent blocking the UI thread.
+// LoadVSPList reloads the list of known VSPs if it is empty or was loaded more
+// than vspListTTL ago. This method may make network calls; should be called in
+// a goroutine to prevent blocking the UI thread.
+func (asset *Asset) LoadVSPList(ctx context.Context) {
+	if !asset.knownVSPs.isFresh(vspListTTL) {
+		asset.ReloadVSPList(ctx)
+	}
+}
+
+// IsLoadingVSPList returns true if the list of known VSPs is being reloaded.
+func (asset *Asset) IsLoadingVSPList() bool {
+	return asset.knownVSPs.isLoading()
+}
+
+// ReloadVSPList reloads the list of known VSPs. If a reload is already in
+// progress, it waits for that reload to complete instead of starting another,
+// unless that reload gets canceled. This method makes multiple network calls;
+// should be called in a goroutine to prevent blocking the UI thread.
 func (asset *Asset) ReloadVSPList(ctx context.Context) {
+	asset.knownVSPs.reload(ctx, asset.fetchVSPList)
+}
+
+// fetchVSPList returns the VSPs saved by the user and the default VSPs of the
+// wallet's network. False is returned if ctx is canceled before all the VSPs
+// are fetched.
+func (asset *Asset) fetchVSPList(ctx context.Context) ([]*VSP, bool) {
 	log.Debugf("Reloading list of known VSPs")
 	defer log.Debugf("Reloaded list of known VSPs")
 
@@ -147,6 +175,7 @@ func (asset *Asset) ReloadVSPList(ctx context.Context) {
 	vspList := make(map[string]*vspd.VspInfoResponse)
 	for _, host := range vspDbData.SavedHosts {
 		vspInfo, err := vspInfo(host)
+		asset.setVSPStatus(host, err == nil)
 		if err != nil {
 			// User saved this VSP. Log an error message.
 			log.Errorf("get vsp info error for %s: %v", host, err)
@@ -154,7 +183,7 @@ func (asset *Asset) ReloadVSPList(ctx context.Context) {
 			vspList[host] = vspInfo
 		}
 		if ctx.Err() != nil {
-			return // context canceled, abort
+			return nil, false // context canceled, abort
 		}
 	}
 
@@ -176,16 +205,59 @@ func (asset *Asset) ReloadVSPList(ctx context.Context) {
 
 		vspList[host] = VSPInfo
 		if ctx.Err() != nil {
-			return // context canceled, abort
+			return nil, false // context canceled, abort
 		}
 	}
 
-	asset.vspMu.Lock()
-	asset.vsps = make([]*VSP, 0, len(vspList))
+	vsps := make([]*VSP, 0, len(vspList))
 	for host, info := range vspList {
-		asset.vsps = append(asset.vsps, &VSP{Host: host, VspInfoResponse: info})
+		vsps = append(vsps, &VSP{Host: host, VspInfoResponse: info})
+	}
+	return vsps, true
+}
+
+// VSPStatus returns the last checked status of the VSP. checked is false if
+// the VSP hasn't been checked yet.
+func (asset *Asset) VSPStatus(host string) (status VSPStatus, checked bool) {
+	asset.vspMu.RLock()
+	defer asset.vspMu.RUnlock()
+	status, checked = asset.vspStatus[host]
+	return
+}
+
+// CheckVSPStatus queries the VSP to find out if it's online. The status is
+// cached and reused for vspStatusTTL so that listing the known VSPs doesn't
+// query every VSP each time.
+func (asset *Asset) CheckVSPStatus(host string) VSPStatus {
+	if status, checked := asset.VSPStatus(host); checked &&
+		time.Since(time.Unix(status.CheckedAt, 0)) < vspStatusTTL {
+		return status
+	}
+
+	_, err := vspInfo(host)
+	if err != nil {
+		log.Debugf("vsp %s is unreachable: %v", host, err)
+	}
+	return asset.setVSPStatus(host, err == nil)
+}
+
+func (asset *Asset) setVSPStatus(host string, online bool) VSPStatus {
+	asset.vspMu.Lock()
+	defer asset.vspMu.Unlock()
+
+	if asset.vspStatus == nil {
+		asset.vspStatus = make(map[string]VSPStatus)
+	}
+
+	now := time.Now().Unix()
+	status := asset.vspStatus[host]
+	status.Online = online
+	status.CheckedAt = now
+	if online {
+		status.LastSeen = now
 	}
-	asset.vspMu.Unlock()
+	asset.vspStatus[host] = status
+	return status
 }
 
 func vspInfo(vspHost string) (*vspd.VspInfoResponse, error) {
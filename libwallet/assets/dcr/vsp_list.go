@@ -0,0 +1,101 @@
+package dcr
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// knownVSPs holds the list of known VSPs. Callers that ask for a reload while
+// one is in progress wait for it instead of starting another.
+type knownVSPs struct {
+	mu       sync.RWMutex
+	vsps     []*VSP
+	loadedAt time.Time
+	// reloading is closed once the reload in progress completes. It is nil
+	// if the list isn't being reloaded.
+	reloading chan struct{}
+	// loads counts the reloads that updated the list so that callers that
+	// waited on a reload can tell whether it completed or was canceled.
+	loads uint64
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+}
+
+// list returns the known VSPs.
+func (k *knownVSPs) list() []*VSP {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.vsps
+}
+
+// add appends vsp to the known VSPs.
+func (k *knownVSPs) add(vsp *VSP) {
+	k.mu.Lock()
+	k.vsps = append(k.vsps, vsp)
+	k.mu.Unlock()
+}
+
+// isFresh returns true if the list isn't empty and was loaded less than ttl
+// ago.
+func (k *knownVSPs) isFresh(ttl time.Duration) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return len(k.vsps) > 0 && k.timeNow().Sub(k.loadedAt) < ttl
+}
+
+// isLoading returns true if the list is being reloaded.
+func (k *knownVSPs) isLoading() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.reloading != nil
+}
+
+// reload replaces the list with the VSPs returned by fetch. fetch returns
+// false if it was canceled, the list is kept as is then. If a reload is in
+// progress, reload waits for it and only reloads again if it was canceled.
+func (k *knownVSPs) reload(ctx context.Context, fetch func(context.Context) ([]*VSP, bool)) {
+	for {
+		k.mu.Lock()
+		if k.reloading == nil {
+			break
+		}
+		reloading, loads := k.reloading, k.loads
+		k.mu.Unlock()
+
+		select {
+		case <-reloading:
+		case <-ctx.Done():
+			return
+		}
+
+		k.mu.RLock()
+		loaded := k.loads != loads
+		k.mu.RUnlock()
+		if loaded {
+			return
+		}
+	}
+	reloading := make(chan struct{})
+	k.reloading = reloading
+	k.mu.Unlock()
+
+	vsps, ok := fetch(ctx)
+
+	k.mu.Lock()
+	if ok {
+		k.vsps = vsps
+		k.loadedAt = k.timeNow()
+		k.loads++
+	}
+	k.reloading = nil
+	k.mu.Unlock()
+	close(reloading)
+}
+
+func (k *knownVSPs) timeNow() time.Time {
+	if k.now != nil {
+		return k.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,141 @@
+package dcr
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKnownVSPsFresh(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	k := &knownVSPs{now: func() time.Time { return now }}
+	fetch := func(context.Context) ([]*VSP, bool) {
+		return []*VSP{{Host: "https://vsp.test"}}, true
+	}
+
+	if k.isFresh(vspListTTL) {
+		t.Fatal("an empty list must not be fresh")
+	}
+	k.reload(context.Background(), fetch)
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    bool
+	}{{
+		name:    "just loaded",
+		elapsed: 0,
+		want:    true,
+	}, {
+		name:    "before the ttl",
+		elapsed: vspListTTL - time.Second,
+		want:    true,
+	}, {
+		name:    "at the ttl",
+		elapsed: vspListTTL,
+		want:    false,
+	}}
+	loadedAt := now
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			now = loadedAt.Add(test.elapsed)
+			if got := k.isFresh(vspListTTL); got != test.want {
+				t.Fatalf("expected fresh %v, got %v", test.want, got)
+			}
+		})
+	}
+
+	// A canceled reload keeps the list and the time it was loaded.
+	now = loadedAt.Add(vspListTTL)
+	k.reload(context.Background(), func(context.Context) ([]*VSP, bool) { return nil, false })
+	if len(k.list()) != 1 || k.isFresh(vspListTTL) {
+		t.Fatalf("expected the stale list to be kept, got %d vsps", len(k.list()))
+	}
+}
+
+func TestKnownVSPsReloadDeduplicated(t *testing.T) {
+	k := new(knownVSPs)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var fetches atomic.Int32
+	fetch := func(context.Context) ([]*VSP, bool) {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return []*VSP{{Host: "https://vsp.test"}}, true
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		k.reload(context.Background(), fetch)
+	}()
+	<-started
+	if !k.isLoading() {
+		t.Fatal("expected the list to be loading")
+	}
+
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			k.reload(context.Background(), fetch)
+		}()
+	}
+	// Give the waiters time to find the reload in progress.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("expected a single fetch, got %d", n)
+	}
+	if k.isLoading() || len(k.list()) != 1 {
+		t.Fatalf("expected the list to be loaded, got %d vsps", len(k.list()))
+	}
+}
+
+func TestKnownVSPsReloadRetriesCanceled(t *testing.T) {
+	k := new(knownVSPs)
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	canceledFetch := func(ctx context.Context) ([]*VSP, bool) {
+		close(started)
+		<-ctx.Done()
+		return nil, false
+	}
+
+	done := make(chan struct{})
+	go func() {
+		k.reload(ctx, canceledFetch)
+		close(done)
+	}()
+	<-started
+
+	waiterDone := make(chan struct{})
+	var fetched atomic.Bool
+	go func() {
+		k.reload(context.Background(), func(context.Context) ([]*VSP, bool) {
+			fetched.Store(true)
+			return []*VSP{{Host: "https://vsp.test"}}, true
+		})
+		close(waiterDone)
+	}()
+	// Give the waiter time to find the reload in progress.
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+
+	select {
+	case <-waiterDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the waiter didn't return")
+	}
+	if !fetched.Load() || len(k.list()) != 1 {
+		t.Fatalf("expected the waiter to reload the list, got %d vsps", len(k.list()))
+	}
+}
@@ -34,7 +34,8 @@ type Asset struct {
 	// VSP data
 	vspClients map[string]*vsp.Client
 	vspMu      sync.RWMutex
-	vsps       []*VSP
+	vspStatus  map[string]VSPStatus
+	knownVSPs  knownVSPs
 
 	notificationListenersMu           sync.RWMutex
 	syncData                          *SyncData
@@ -149,13 +149,15 @@ func (asset *Asset) GetAPIFeeEstimateRate() (feerates []sharedW.FeeEstimate, err
 }
 
 // SetUserFeeRate sets the fee rate in kvB units. Setting fee rate less than
-// MinFeeRatePerkvB is not allowed.
+// the wallet's minimum fee rate (see GetMinFeeRate) is not allowed.
 func (asset *Asset) SetUserFeeRate(feeRatePerkvB sharedW.AssetAmount) error {
+	minFeeRate := ltcutil.Amount(asset.GetMinFeeRate().ToInt())
+
 	asset.fees.mu.Lock()
 	defer asset.fees.mu.Unlock()
 
-	if feeRatePerkvB.ToInt() < int64(MinFeeRatePerkvB) {
-		return fmt.Errorf("minimum rate is %d Lit/kvB", int64(MinFeeRatePerkvB))
+	if err := checkFeeRateFloor(ltcutil.Amount(feeRatePerkvB.ToInt()), minFeeRate); err != nil {
+		return err
 	}
 
 	asset.fees.SetFeeRatePerkvB = feeRatePerkvB
@@ -174,3 +176,36 @@ func (asset *Asset) GetUserFeeRate() sharedW.AssetAmount {
 	}
 	return asset.fees.SetFeeRatePerkvB
 }
+
+// GetMinFeeRate returns the minimum fee rate in kvB units that transactions
+// built by this wallet may use. If not configured it defaults to
+// MinFeeRatePerkvB, the network relay minimum.
+func (asset *Asset) GetMinFeeRate() sharedW.AssetAmount {
+	minFeeRate := asset.ReadLongConfigValueForKey(sharedW.MinFeeRateConfigKey, int64(MinFeeRatePerkvB))
+	if minFeeRate < int64(MinFeeRatePerkvB) {
+		minFeeRate = int64(MinFeeRatePerkvB)
+	}
+	return Amount(minFeeRate)
+}
+
+// SetMinFeeRate sets the minimum fee rate in kvB units that transactions built
+// by this wallet may use. Setting a floor less than MinFeeRatePerkvB is not
+// allowed.
+func (asset *Asset) SetMinFeeRate(feeRatePerkvB sharedW.AssetAmount) error {
+	if err := checkFeeRateFloor(ltcutil.Amount(feeRatePerkvB.ToInt()), MinFeeRatePerkvB); err != nil {
+		return err
+	}
+
+	asset.SetLongConfigValueForKey(sharedW.MinFeeRateConfigKey, feeRatePerkvB.ToInt())
+	return nil
+}
+
+// checkFeeRateFloor returns an error if the provided fee rate is below the
+// minimum fee rate allowed.
+func checkFeeRateFloor(feeRatePerkvB, minFeeRatePerkvB ltcutil.Amount) error {
+	if feeRatePerkvB < minFeeRatePerkvB {
+		return fmt.Errorf("fee rate of %d Lit/kvB is below the minimum rate of %d Lit/kvB",
+			int64(feeRatePerkvB), int64(minFeeRatePerkvB))
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package ltc
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/asdine/storm"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// testFeeRateAsset returns an asset whose config is persisted in a temporary
+// db, enough to read and write its fee rates.
+func testFeeRateAsset(t *testing.T) *Asset {
+	t.Helper()
+	rootDir := t.TempDir()
+	db, err := storm.Open(filepath.Join(rootDir, "wallets.db"))
+	if err != nil {
+		t.Fatalf("storm.Open error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	w := &sharedW.Wallet{ID: 1, Type: utils.LTCWalletAsset}
+	params := &sharedW.InitParams{RootDir: rootDir, NetType: utils.Testnet, DB: db}
+	dataDir := filepath.Join(rootDir, utils.NetDir(w.Type, params.NetType), w.Type.ToStringLower(), "1")
+	if err := os.MkdirAll(dataDir, utils.UserFilePerm); err != nil {
+		t.Fatalf("MkdirAll error: %v", err)
+	}
+	if err := w.Prepare(nil, params); err != nil {
+		t.Fatalf("Prepare error: %v", err)
+	}
+	t.Cleanup(func() { w.GetWalletDataDb().Close() })
+	return &Asset{Wallet: w}
+}
+
+func TestMinFeeRate(t *testing.T) {
+	asset := testFeeRateAsset(t)
+
+	if rate := asset.GetMinFeeRate().ToInt(); rate != int64(MinFeeRatePerkvB) {
+		t.Fatalf("expected the relay minimum %d by default, got %d", int64(MinFeeRatePerkvB), rate)
+	}
+	if err := asset.SetMinFeeRate(Amount(MinFeeRatePerkvB - 1)); err == nil {
+		t.Fatal("expected a floor below the relay minimum to be rejected")
+	}
+	if err := asset.SetUserFeeRate(Amount(5000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := asset.SetMinFeeRate(Amount(10000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rate := asset.GetMinFeeRate().ToInt(); rate != 10000 {
+		t.Fatalf("expected a floor of 10000, got %d", rate)
+	}
+
+	if err := asset.SetUserFeeRate(Amount(9999)); err == nil {
+		t.Fatal("expected a fee rate below the floor to be rejected")
+	}
+	if rate := asset.GetUserFeeRate().ToInt(); rate != 5000 {
+		t.Fatalf("expected the rejected rate not to be applied, got %d", rate)
+	}
+
+	// The rate set before the floor was raised can't be used to build a tx.
+	if _, err := asset.constructTransaction(); err == nil || !strings.Contains(err.Error(), "below the minimum") {
+		t.Fatalf("expected a tx paying less than the floor to be rejected, got %v", err)
+	}
+
+	if err := asset.SetUserFeeRate(Amount(10000)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -348,6 +348,102 @@ func (asset *Asset) Broadcast(privatePassphrase, transactionLabel string) (strin
 	return txHash.String(), utils.TranslateError(err)
 }
 
+// CreateUnsignedTransaction returns the serialized raw transaction authored
+// with NewUnsignedTx and AddSendDestination without signing it, so it can be
+// signed offline by a wallet that holds the private keys.
+func (asset *Asset) CreateUnsignedTransaction() ([]byte, error) {
+	if !asset.WalletOpened() {
+		return nil, utils.ErrLTCNotInitialized
+	}
+
+	if asset.TxAuthoredInfo == nil {
+		return nil, fmt.Errorf("TxAuthoredInfo is nil")
+	}
+
+	asset.TxAuthoredInfo.mu.Lock()
+	defer asset.TxAuthoredInfo.mu.Unlock()
+
+	unsignedTx, err := asset.unsignedTransaction()
+	if err != nil {
+		return nil, utils.TranslateError(err)
+	}
+
+	if unsignedTx.ChangeIndex > 0 {
+		unsignedTx.RandomizeChangePosition()
+	}
+
+	msgTx := unsignedTx.Tx.Copy()
+	msgTx.LockTime = uint32(asset.GetBestBlockHeight())
+
+	var buf bytes.Buffer
+	buf.Grow(msgTx.SerializeSize())
+	if err := msgTx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	// The exported tx is persisted so its signed version can be imported
+	// even after the send form is edited or the app is restarted.
+	if err := asset.SaveExportedTx(exportedTx(msgTx)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ImportSignedTransaction broadcasts a raw transaction that was exported with
+// CreateUnsignedTransaction and signed offline, and returns its hash.
+// Transactions that don't spend the inputs of an exported unsigned transaction
+// to its outputs are rejected.
+func (asset *Asset) ImportSignedTransaction(data []byte) (string, error) {
+	if !asset.WalletOpened() {
+		return "", utils.ErrLTCNotInitialized
+	}
+
+	msgTx := new(wire.MsgTx)
+	if err := msgTx.Deserialize(bytes.NewReader(data)); err != nil {
+		return "", fmt.Errorf("decoding the signed transaction failed: %w", err)
+	}
+
+	exported, err := asset.FindExportedTx(exportedTx(msgTx))
+	if err != nil {
+		return "", err
+	}
+
+	if err := asset.Internal().LTC.PublishTransaction(msgTx, ""); err != nil {
+		return "", utils.TranslateError(err)
+	}
+
+	if err := asset.RemoveExportedTx(exported.Hash); err != nil {
+		log.Errorf("removing the exported tx %s failed: %v", exported.Hash, err)
+	}
+	return msgTx.TxHash().String(), nil
+}
+
+// exportedTx returns the inputs and outputs of tx as they are persisted for
+// the unsigned transactions exported with CreateUnsignedTransaction.
+func exportedTx(tx *wire.MsgTx) *sharedW.ExportedTx {
+	return &sharedW.ExportedTx{
+		Hash:    tx.TxHash().String(),
+		Inputs:  txInputs(tx),
+		Outputs: txOutputs(tx),
+	}
+}
+
+func txInputs(tx *wire.MsgTx) []string {
+	inputs := make([]string, 0, len(tx.TxIn))
+	for _, txIn := range tx.TxIn {
+		inputs = append(inputs, txIn.PreviousOutPoint.String())
+	}
+	return inputs
+}
+
+func txOutputs(tx *wire.MsgTx) []string {
+	outputs := make([]string, 0, len(tx.TxOut))
+	for _, txOut := range tx.TxOut {
+		outputs = append(outputs, fmt.Sprintf("%d:%x", txOut.Value, txOut.PkScript))
+	}
+	return outputs
+}
+
 func (asset *Asset) unsignedTransaction() (*txauthor.AuthoredTx, error) {
 	if asset.TxAuthoredInfo.needsConstruct || asset.TxAuthoredInfo.unsignedTx == nil {
 		unsignedTx, err := asset.constructTransaction()
@@ -369,6 +465,12 @@ func (asset *Asset) constructTransaction() (*txauthor.AuthoredTx, error) {
 	setFeeRate := ltcutil.Amount(asset.GetUserFeeRate().ToInt())
 	var sendMax bool
 
+	// Transactions paying less than the configured minimum fee rate risk
+	// never being confirmed, reject them before building anything.
+	if err = checkFeeRateFloor(setFeeRate, ltcutil.Amount(asset.GetMinFeeRate().ToInt())); err != nil {
+		return nil, err
+	}
+
 	for _, destination := range asset.TxAuthoredInfo.destinations {
 		if err := asset.validateSendAmount(destination.SendMax, destination.UnitAmount); err != nil {
 			return nil, err
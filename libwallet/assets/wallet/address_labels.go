@@ -0,0 +1,52 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxAddressLabelLength is the maximum number of characters allowed in an
+// address label.
+const MaxAddressLabelLength = 40
+
+// AddressLabels returns the labels of all labelled addresses keyed by address.
+func (wallet *Wallet) AddressLabels() map[string]string {
+	wallet.addressLabelsMu.Lock()
+	defer wallet.addressLabelsMu.Unlock()
+	return wallet.readAddressLabels()
+}
+
+// GetAddressLabel returns the label set for the provided address or an empty
+// string if the address isn't labelled.
+func (wallet *Wallet) GetAddressLabel(address string) string {
+	return wallet.AddressLabels()[address]
+}
+
+// SetAddressLabel labels the provided address. An empty label removes any
+// label previously set for the address.
+func (wallet *Wallet) SetAddressLabel(address, label string) error {
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > MaxAddressLabelLength {
+		return fmt.Errorf("address label exceeds %d characters", MaxAddressLabelLength)
+	}
+
+	wallet.addressLabelsMu.Lock()
+	defer wallet.addressLabelsMu.Unlock()
+
+	labels := wallet.readAddressLabels()
+	if label == "" {
+		delete(labels, address)
+	} else {
+		labels[address] = label
+	}
+	return wallet.walletConfigSave(AddressLabelsConfigKey, labels)
+}
+
+// readAddressLabels reads the persisted labels. wallet.addressLabelsMu MUST be
+// held.
+func (wallet *Wallet) readAddressLabels() map[string]string {
+	labels := make(map[string]string)
+	_ = wallet.ReadUserConfigValue(AddressLabelsConfigKey, &labels)
+	return labels
+}
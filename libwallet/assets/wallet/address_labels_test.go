@@ -0,0 +1,32 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetAddressLabel(t *testing.T) {
+	wallet := testWallet(t)
+
+	if err := wallet.SetAddressLabel("addr1", "savings"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := wallet.SetAddressLabel("addr2", strings.Repeat("a", MaxAddressLabelLength+1)); err == nil {
+		t.Fatal("expected an error for a label that is too long")
+	}
+
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	if label := other.GetAddressLabel("addr1"); label != "savings" {
+		t.Fatalf("expected label %q, got %q", "savings", label)
+	}
+	if labels := other.AddressLabels(); len(labels) != 1 {
+		t.Fatalf("expected 1 label, got %d", len(labels))
+	}
+
+	if err := wallet.SetAddressLabel("addr1", " "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if label := other.GetAddressLabel("addr1"); label != "" {
+		t.Fatalf("expected the label to be removed, got %q", label)
+	}
+}
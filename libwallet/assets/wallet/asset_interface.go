@@ -17,6 +17,8 @@ type Asset interface {
 	CancelSync()
 	IsRescanning() bool
 	RescanBlocks() error
+	VerifyIntegrity(ctx context.Context, progress IntegrityProgressFn) (*IntegrityReport, error)
+	RepairIntegrity(ctx context.Context, report *IntegrityReport, progress IntegrityProgressFn) error
 	ConnectedPeers() int32
 	RemovePeers()
 	SetSpecificPeer(address string)
@@ -33,6 +35,11 @@ type Asset interface {
 	GetWalletID() int
 	GetWalletName() string
 	IsWatchingOnlyWallet() bool
+	CanSign() bool
+	IsHidden() bool
+	SetHidden(hidden bool)
+	DisplayOrder() int
+	SetDisplayOrder(order int)
 	UnlockWallet(string) error
 	DeleteWallet(privPass string) error
 	RenameWallet(newName string) error
@@ -59,6 +66,15 @@ type Asset interface {
 	GetTransactionRaw(txHash string) (*Transaction, error)
 	TxMatchesFilter(tx *Transaction, txFilter int32) bool
 	GetTransactionsRaw(offset, limit, txFilter int32, newestFirst bool, txHashSearch string) ([]*Transaction, error)
+	TxTag(txHash string) string
+	SetTxTag(txHash, tag string) error
+	TxTagsMap() map[string]string
+	TxLabelsMap() map[string]string
+	GetTxLabel(txHash string) string
+	SetTxLabel(txHash, label string) error
+	AddressLabels() map[string]string
+	GetAddressLabel(address string) string
+	SetAddressLabel(address, label string) error
 
 	GetBestBlock() *BlockInfo
 	GetBestBlockHeight() int32
@@ -112,6 +128,8 @@ type Asset interface {
 	AddSendDestination(id int, address string, unitAmount int64, sendMax bool) error
 	ComputeTxSizeEstimation(dstAddress string, utxos []*UnspentOutput) (int, error)
 	Broadcast(passphrase, label string) (string, error)
+	CreateUnsignedTransaction() ([]byte, error)
+	ImportSignedTransaction(data []byte) (string, error)
 	EstimateFeeAndSize() (*TxFeeAndSize, error)
 	IsUnsignedTxExist() bool
 	RemoveSendDestination(id int)
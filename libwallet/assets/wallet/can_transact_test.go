@@ -0,0 +1,49 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// transactAsset stubs the Asset methods used by CanTransact.
+type transactAsset struct {
+	Asset
+	watchOnly, connected, synced, rescanning bool
+}
+
+func (a transactAsset) CanSign() bool              { return !a.watchOnly }
+func (a transactAsset) IsConnectedToNetwork() bool { return a.connected }
+func (a transactAsset) IsSynced() bool             { return a.synced }
+func (a transactAsset) IsRescanning() bool         { return a.rescanning }
+
+func TestCanTransact(t *testing.T) {
+	tests := []struct {
+		name       string
+		asset      transactAsset
+		wantOK     bool
+		wantReason string
+	}{
+		{"ready", transactAsset{connected: true, synced: true}, true, ""},
+		{"watch only", transactAsset{watchOnly: true, connected: true, synced: true}, false, utils.ErrWalletIsWatchOnly},
+		{"not connected", transactAsset{}, false, utils.ErrNotConnected},
+		{"syncing", transactAsset{connected: true}, false, utils.ErrNotSynced},
+		{"rescanning", transactAsset{connected: true, synced: true, rescanning: true}, false, utils.ErrNotSynced},
+	}
+	for _, test := range tests {
+		ok, reason := CanTransact(test.asset)
+		if ok != test.wantOK || reason != test.wantReason {
+			t.Errorf("%s: expected (%v, %q), got (%v, %q)", test.name, test.wantOK, test.wantReason, ok, reason)
+		}
+	}
+}
+
+func TestCanBroadcast(t *testing.T) {
+	// A watch-only wallet broadcasts transactions signed offline.
+	if ok, reason := CanBroadcast(transactAsset{watchOnly: true, connected: true, synced: true}); !ok {
+		t.Errorf("expected a watch-only wallet to broadcast, got %q", reason)
+	}
+	if ok, reason := CanBroadcast(transactAsset{watchOnly: true, connected: true}); ok || reason != utils.ErrNotSynced {
+		t.Errorf("expected (false, %q), got (%v, %q)", utils.ErrNotSynced, ok, reason)
+	}
+}
@@ -0,0 +1,52 @@
+package wallet
+
+import "errors"
+
+// maxChangeAddressAttempts bounds the number of addresses derived while
+// looking for a fresh change address.
+const maxChangeAddressAttempts = 20
+
+// ChangeAddressesToAvoid returns the addresses a change output should not be
+// sent to as doing so would link the change to the recipients or to the
+// inputs being spent.
+func ChangeAddressesToAvoid(destinations map[int]*TransactionDestination, utxos []*UnspentOutput) map[string]bool {
+	avoid := make(map[string]bool, len(destinations)+len(utxos))
+	for _, destination := range destinations {
+		avoid[destination.Address] = true
+	}
+	for _, utxo := range utxos {
+		if utxo.Address != "" {
+			avoid[utxo.Address] = true
+		}
+	}
+	return avoid
+}
+
+// PickChangeAddress derives addresses using next until one that is not in
+// avoid and has never received funds is found. If no such address is found
+// within a bounded number of attempts, e.g. when the wallet has wrapped
+// around its gap limit, the first derived address that is not in avoid is
+// returned instead.
+func PickChangeAddress(next func() (string, error), isUsed func(address string) bool, avoid map[string]bool) (string, error) {
+	var fallback string
+	for i := 0; i < maxChangeAddressAttempts; i++ {
+		address, err := next()
+		if err != nil {
+			return "", err
+		}
+		if avoid[address] {
+			continue
+		}
+		if !isUsed(address) {
+			return address, nil
+		}
+		if fallback == "" {
+			fallback = address
+		}
+	}
+
+	if fallback == "" {
+		return "", errors.New("no change address distinct from the tx addresses found")
+	}
+	return fallback, nil
+}
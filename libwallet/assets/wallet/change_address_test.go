@@ -0,0 +1,93 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPickChangeAddress(t *testing.T) {
+	tests := []struct {
+		name      string
+		derived   []string
+		used      []string
+		avoid     []string
+		want      string
+		wantError bool
+	}{{
+		name:    "first address is fresh",
+		derived: []string{"a", "b"},
+		want:    "a",
+	}, {
+		name:    "skips used addresses",
+		derived: []string{"a", "b", "c"},
+		used:    []string{"a", "b"},
+		want:    "c",
+	}, {
+		name:    "skips recipient and input addresses",
+		derived: []string{"a", "b", "c"},
+		avoid:   []string{"a", "b"},
+		want:    "c",
+	}, {
+		name:    "falls back to a used address not in the tx",
+		derived: []string{"a", "b"},
+		used:    []string{"a", "b"},
+		avoid:   []string{"a"},
+		want:    "b",
+	}, {
+		name:      "every address is in the tx",
+		derived:   []string{"a"},
+		avoid:     []string{"a"},
+		wantError: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			used := make(map[string]bool)
+			for _, address := range test.used {
+				used[address] = true
+			}
+			destinations := make(map[int]*TransactionDestination)
+			for i, address := range test.avoid {
+				destinations[i] = &TransactionDestination{Address: address}
+			}
+
+			// The last derived address is returned again once all the
+			// others have been derived, mimicking a wallet wrapping around
+			// its gap limit.
+			var i int
+			next := func() (string, error) {
+				if len(test.derived) == 0 {
+					return "", errors.New("no addresses")
+				}
+				address := test.derived[min(i, len(test.derived)-1)]
+				i++
+				return address, nil
+			}
+			isUsed := func(address string) bool { return used[address] }
+
+			got, err := PickChangeAddress(next, isUsed, ChangeAddressesToAvoid(destinations, nil))
+			if test.wantError {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestChangeAddressesToAvoid(t *testing.T) {
+	destinations := map[int]*TransactionDestination{0: {Address: "recipient"}}
+	utxos := []*UnspentOutput{{Address: "input"}, {Address: ""}}
+
+	avoid := ChangeAddressesToAvoid(destinations, utxos)
+	if len(avoid) != 2 || !avoid["recipient"] || !avoid["input"] {
+		t.Fatalf("unexpected addresses to avoid: %v", avoid)
+	}
+}
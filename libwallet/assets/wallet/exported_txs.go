@@ -0,0 +1,88 @@
+package wallet
+
+import (
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// maxExportedTxs is the number of exported unsigned transactions kept, the
+// oldest is dropped when another one is exported.
+const maxExportedTxs = 10
+
+// ExportedTx is an unsigned transaction exported for offline signing. Its
+// inputs and outputs are stored as the keys the assets build from them, e.g.
+// the previous outpoint of an input, so the signed transaction can be checked
+// against it after the app is restarted.
+type ExportedTx struct {
+	Hash    string   `json:"hash"`
+	Inputs  []string `json:"inputs"`
+	Outputs []string `json:"outputs"`
+}
+
+// Match returns utils.ErrSignedTxMismatch unless signed spends exactly the
+// inputs of the exported transaction to exactly its outputs, in any order.
+func (tx *ExportedTx) Match(signed *ExportedTx) error {
+	return utils.MatchUnsignedTx(signed.Inputs, tx.Inputs, signed.Outputs, tx.Outputs)
+}
+
+// SaveExportedTx persists an unsigned transaction exported for offline
+// signing. A transaction exported before with the same hash is replaced.
+func (wallet *Wallet) SaveExportedTx(tx *ExportedTx) error {
+	wallet.exportedTxsMu.Lock()
+	defer wallet.exportedTxsMu.Unlock()
+
+	txs := wallet.readExportedTxs()
+	kept := make([]*ExportedTx, 0, len(txs)+1)
+	for _, exported := range txs {
+		if exported.Hash != tx.Hash {
+			kept = append(kept, exported)
+		}
+	}
+	kept = append(kept, tx)
+	if len(kept) > maxExportedTxs {
+		kept = kept[len(kept)-maxExportedTxs:]
+	}
+	return wallet.walletConfigSave(ExportedTxsConfigKey, kept)
+}
+
+// FindExportedTx returns the exported unsigned transaction signed was created
+// from. utils.ErrNoUnsignedTx is returned if no transaction was exported and
+// utils.ErrSignedTxMismatch if none matches signed.
+func (wallet *Wallet) FindExportedTx(signed *ExportedTx) (*ExportedTx, error) {
+	wallet.exportedTxsMu.Lock()
+	defer wallet.exportedTxsMu.Unlock()
+
+	txs := wallet.readExportedTxs()
+	if len(txs) == 0 {
+		return nil, utils.ErrNoUnsignedTx
+	}
+	for _, exported := range txs {
+		if exported.Match(signed) == nil {
+			return exported, nil
+		}
+	}
+	return nil, utils.ErrSignedTxMismatch
+}
+
+// RemoveExportedTx forgets the exported unsigned transaction with the provided
+// hash, once its signed version is broadcast.
+func (wallet *Wallet) RemoveExportedTx(hash string) error {
+	wallet.exportedTxsMu.Lock()
+	defer wallet.exportedTxsMu.Unlock()
+
+	txs := wallet.readExportedTxs()
+	kept := make([]*ExportedTx, 0, len(txs))
+	for _, exported := range txs {
+		if exported.Hash != hash {
+			kept = append(kept, exported)
+		}
+	}
+	return wallet.walletConfigSave(ExportedTxsConfigKey, kept)
+}
+
+// readExportedTxs reads the persisted exported transactions, oldest first.
+// wallet.exportedTxsMu MUST be held.
+func (wallet *Wallet) readExportedTxs() []*ExportedTx {
+	var txs []*ExportedTx
+	_ = wallet.ReadUserConfigValue(ExportedTxsConfigKey, &txs)
+	return txs
+}
@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestExportedTxs(t *testing.T) {
+	wallet := testWallet(t)
+	exported := &ExportedTx{
+		Hash:    "tx1",
+		Inputs:  []string{"a:0", "b:1"},
+		Outputs: []string{"1000:0014aa", "2000:0014bb"},
+	}
+
+	if _, err := wallet.FindExportedTx(exported); !errors.Is(err, utils.ErrNoUnsignedTx) {
+		t.Fatalf("expected %v before a tx is exported, got %v", utils.ErrNoUnsignedTx, err)
+	}
+	if err := wallet.SaveExportedTx(exported); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The exported tx is read back from the db, e.g. after a restart, and
+	// signers may reorder the inputs and outputs.
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	signed := &ExportedTx{
+		Inputs:  []string{"b:1", "a:0"},
+		Outputs: []string{"2000:0014bb", "1000:0014aa"},
+	}
+	found, err := other.FindExportedTx(signed)
+	if err != nil || found.Hash != "tx1" {
+		t.Fatalf("expected tx1 to match, got %v, %v", found, err)
+	}
+
+	mismatch := &ExportedTx{Inputs: signed.Inputs, Outputs: []string{"2000:0014bb", "1500:0014aa"}}
+	if _, err := other.FindExportedTx(mismatch); !errors.Is(err, utils.ErrSignedTxMismatch) {
+		t.Fatalf("expected %v, got %v", utils.ErrSignedTxMismatch, err)
+	}
+
+	if err := other.RemoveExportedTx("tx1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := wallet.FindExportedTx(signed); !errors.Is(err, utils.ErrNoUnsignedTx) {
+		t.Fatalf("expected the tx to be removed, got %v", err)
+	}
+}
+
+func TestExportedTxsLimit(t *testing.T) {
+	wallet := testWallet(t)
+	record := func(i int) *ExportedTx {
+		return &ExportedTx{Hash: fmt.Sprint(i), Inputs: []string{fmt.Sprintf("in:%d", i)}, Outputs: []string{"1000:00"}}
+	}
+	for i := 0; i <= maxExportedTxs; i++ {
+		if err := wallet.SaveExportedTx(record(i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _, err := wallet.FindExportedTx(record(0)); !errors.Is(err, utils.ErrSignedTxMismatch) {
+		t.Fatalf("expected the oldest tx to be dropped, got %v", err)
+	}
+	if found, err := wallet.FindExportedTx(record(maxExportedTxs)); err != nil || found.Hash != fmt.Sprint(maxExportedTxs) {
+		t.Fatalf("expected the newest tx to be kept, got %v, %v", found, err)
+	}
+}
@@ -0,0 +1,92 @@
+package wallet
+
+import "context"
+
+// IntegrityIssueKind identifies the part of the wallet data an integrity issue
+// was found in.
+type IntegrityIssueKind int
+
+const (
+	// BlockHeadersIssue indicates a break in the stored block header chain.
+	BlockHeadersIssue IntegrityIssueKind = iota
+	// FilterHeadersIssue indicates that the stored cfilter headers don't line
+	// up with the stored block headers.
+	FilterHeadersIssue
+	// WalletSyncIssue indicates that the block the wallet reports being synced
+	// to is not part of the stored header chain.
+	WalletSyncIssue
+)
+
+// IntegrityIssue describes a problem found while verifying a wallet's data.
+type IntegrityIssue struct {
+	Kind        IntegrityIssueKind
+	Height      int32 // Height at which the issue was found.
+	Description string
+	// Recoverable is true if the issue can be fixed by RepairIntegrity.
+	Recoverable bool
+}
+
+// IntegrityReport holds the result of a wallet integrity check.
+type IntegrityReport struct {
+	CheckedHeight int32 // Height of the tip the check ran against.
+	Issues        []IntegrityIssue
+}
+
+// HasIssues returns true if the integrity check found any problem.
+func (r *IntegrityReport) HasIssues() bool {
+	return len(r.Issues) > 0
+}
+
+// IsRecoverable returns true if issues were found and all of them can be
+// repaired.
+func (r *IntegrityReport) IsRecoverable() bool {
+	if !r.HasIssues() {
+		return false
+	}
+	for _, issue := range r.Issues {
+		if !issue.Recoverable {
+			return false
+		}
+	}
+	return true
+}
+
+// IntegrityProgressFn receives the progress of an integrity check or repair
+// as a percentage.
+type IntegrityProgressFn func(percent int32)
+
+// HeaderFetcher returns the hash of the block header stored at the provided
+// height and the hash of its previous block.
+type HeaderFetcher func(height int32) (hash, prevHash [32]byte, err error)
+
+// CheckHeaderContinuity walks the stored headers from the genesis block up to
+// tipHeight and returns the first height whose header doesn't link to the
+// header below it or can't be read. -1 is returned if the header chain is
+// continuous. An error is only returned if ctx is canceled.
+func CheckHeaderContinuity(ctx context.Context, tipHeight int32, fetch HeaderFetcher, progress IntegrityProgressFn) (int32, error) {
+	prevHash, _, err := fetch(0)
+	if err != nil {
+		return 0, nil
+	}
+
+	var lastPercent int32 = -1
+	for height := int32(1); height <= tipHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return -1, err
+		}
+
+		hash, linkedHash, err := fetch(height)
+		if err != nil || linkedHash != prevHash {
+			return height, nil
+		}
+		prevHash = hash
+
+		if progress != nil {
+			if percent := height * 100 / tipHeight; percent != lastPercent {
+				lastPercent = percent
+				progress(percent)
+			}
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// testHeaderChain returns a fetcher over a chain of n headers where header i
+// has hash {i} and links to header i-1. Heights in broken link to a wrong
+// hash and heights in missing can't be read.
+func testHeaderChain(broken, missing map[int32]bool) HeaderFetcher {
+	return func(height int32) (hash, prevHash [32]byte, err error) {
+		if missing[height] {
+			return hash, prevHash, errors.New("header not found")
+		}
+		hash[0] = byte(height)
+		if height > 0 {
+			prevHash[0] = byte(height - 1)
+		}
+		if broken[height] {
+			prevHash[1] = 0xff
+		}
+		return hash, prevHash, nil
+	}
+}
+
+func TestCheckHeaderContinuity(t *testing.T) {
+	tests := []struct {
+		name    string
+		broken  map[int32]bool
+		missing map[int32]bool
+		want    int32
+	}{
+		{name: "continuous chain", want: -1},
+		{name: "broken link", broken: map[int32]bool{7: true, 9: true}, want: 7},
+		{name: "missing header", missing: map[int32]bool{5: true}, want: 5},
+		{name: "missing genesis", missing: map[int32]bool{0: true}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lastProgress int32
+			got, err := CheckHeaderContinuity(context.Background(), 10, testHeaderChain(tt.broken, tt.missing), func(percent int32) {
+				lastProgress = percent
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected first bad height %d, got %d", tt.want, got)
+			}
+			if tt.want == -1 && lastProgress != 100 {
+				t.Fatalf("expected progress to reach 100, got %d", lastProgress)
+			}
+		})
+	}
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := CheckHeaderContinuity(ctx, 10, testHeaderChain(nil, nil), nil); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"sync"
+	"time"
+)
+
+// RescanProgress is a snapshot of the progress of a blocks rescan.
+type RescanProgress struct {
+	WalletID      int
+	ScannedHeight int32
+	TargetHeight  int32
+	ETA           time.Duration
+}
+
+// Percent returns the share of the blocks to scan that has been scanned.
+func (p RescanProgress) Percent() int32 {
+	if p.TargetHeight <= 0 {
+		return 0
+	}
+	if p.ScannedHeight >= p.TargetHeight {
+		return 100
+	}
+	return p.ScannedHeight * 100 / p.TargetHeight
+}
+
+// RescanProgressFeed delivers the progress of a blocks rescan on a channel.
+// Updates are throttled to at most one per interval, except the update that
+// completes the rescan which is always delivered. Only the latest update is
+// kept if the receiver falls behind. The channel is closed once the rescan
+// ends, including when it is canceled.
+type RescanProgressFeed struct {
+	interval time.Duration
+	progress chan RescanProgress
+	now      func() time.Time
+
+	mu       sync.Mutex
+	lastSent time.Time
+	closed   bool
+	err      error
+}
+
+// NewRescanProgressFeed returns a feed that delivers at most one progress
+// update per interval.
+func NewRescanProgressFeed(interval time.Duration) *RescanProgressFeed {
+	return &RescanProgressFeed{
+		interval: interval,
+		progress: make(chan RescanProgress, 1),
+		now:      time.Now,
+	}
+}
+
+// Progress returns the channel the rescan progress is delivered on.
+func (f *RescanProgressFeed) Progress() <-chan RescanProgress {
+	return f.progress
+}
+
+// Err returns the error the rescan ended with. It should only be checked
+// once the progress channel is closed.
+func (f *RescanProgressFeed) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// Listener returns the listener to register with the asset being rescanned
+// using SetBlocksRescanProgressListener.
+func (f *RescanProgressFeed) Listener() *BlocksRescanProgressListener {
+	return &BlocksRescanProgressListener{
+		OnBlocksRescanStarted:  func(_ int) {},
+		OnBlocksRescanProgress: f.update,
+		OnBlocksRescanEnded: func(_ int, err error) {
+			f.Close(err)
+		},
+	}
+}
+
+// Close ends the feed, closing the progress channel. It is safe to call Close
+// more than once, only the first error is kept.
+func (f *RescanProgressFeed) Close(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+	f.closed = true
+	f.err = err
+	close(f.progress)
+}
+
+func (f *RescanProgressFeed) update(report *HeadersRescanProgressReport) {
+	progress := RescanProgress{
+		WalletID:      report.WalletID,
+		ScannedHeight: report.CurrentRescanHeight,
+		TargetHeight:  report.TotalHeadersToScan,
+		ETA:           report.RescanTimeRemaining,
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+
+	now := f.now()
+	isComplete := progress.ScannedHeight >= progress.TargetHeight
+	if !f.lastSent.IsZero() && now.Sub(f.lastSent) < f.interval && !isComplete {
+		return
+	}
+	f.lastSent = now
+
+	// Drop the update the receiver hasn't read yet in favour of this one.
+	select {
+	case <-f.progress:
+	default:
+	}
+	f.progress <- progress
+}
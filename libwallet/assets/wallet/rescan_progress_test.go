@@ -0,0 +1,116 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRescanProgressFeed(t *testing.T) {
+	start := time.Unix(0, 0)
+	tests := []struct {
+		name string
+		// offsets of the reports from the start of the rescan.
+		offsets []time.Duration
+		heights []int32
+		want    []int32
+	}{{
+		name:    "first update is delivered",
+		offsets: []time.Duration{0},
+		heights: []int32{10},
+		want:    []int32{10},
+	}, {
+		name:    "updates within the interval are dropped",
+		offsets: []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond},
+		heights: []int32{10, 20, 30},
+		want:    []int32{10},
+	}, {
+		name:    "updates after the interval are delivered",
+		offsets: []time.Duration{0, time.Second},
+		heights: []int32{10, 20},
+		want:    []int32{20},
+	}, {
+		name:    "completing update is never dropped",
+		offsets: []time.Duration{0, 100 * time.Millisecond},
+		heights: []int32{10, 100},
+		want:    []int32{100},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			feed := NewRescanProgressFeed(time.Second)
+			var now time.Time
+			feed.now = func() time.Time { return now }
+			listener := feed.Listener()
+
+			for i, offset := range test.offsets {
+				now = start.Add(offset)
+				listener.OnBlocksRescanProgress(&HeadersRescanProgressReport{
+					CurrentRescanHeight: test.heights[i],
+					TotalHeadersToScan:  100,
+				})
+			}
+			listener.OnBlocksRescanEnded(0, nil)
+
+			var got []int32
+			for progress := range feed.Progress() {
+				got = append(got, progress.ScannedHeight)
+			}
+			if len(got) != len(test.want) {
+				t.Fatalf("expected heights %v, got %v", test.want, got)
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Fatalf("expected heights %v, got %v", test.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRescanProgressFeedClose(t *testing.T) {
+	feed := NewRescanProgressFeed(time.Second)
+	listener := feed.Listener()
+	rescanErr := errors.New("rescan failed")
+
+	listener.OnBlocksRescanEnded(0, rescanErr)
+	// Closing again and reporting progress after the rescan ended must not
+	// panic.
+	feed.Close(nil)
+	listener.OnBlocksRescanProgress(&HeadersRescanProgressReport{CurrentRescanHeight: 1, TotalHeadersToScan: 10})
+
+	if _, ok := <-feed.Progress(); ok {
+		t.Fatal("expected the progress channel to be closed")
+	}
+	if !errors.Is(feed.Err(), rescanErr) {
+		t.Fatalf("expected error %v, got %v", rescanErr, feed.Err())
+	}
+}
+
+func TestRescanProgressPercent(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress RescanProgress
+		want     int32
+	}{{
+		name:     "unknown target",
+		progress: RescanProgress{ScannedHeight: 10},
+		want:     0,
+	}, {
+		name:     "halfway",
+		progress: RescanProgress{ScannedHeight: 50, TargetHeight: 100},
+		want:     50,
+	}, {
+		name:     "past the target",
+		progress: RescanProgress{ScannedHeight: 120, TargetHeight: 100},
+		want:     100,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.progress.Percent(); got != test.want {
+				t.Fatalf("expected %d, got %d", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package wallet
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxTxLabelLength is the maximum number of characters allowed in a
+// transaction label.
+const MaxTxLabelLength = 100
+
+// GetTxLabel returns the label set for the transaction with the provided hash
+// or an empty string if the transaction isn't labelled. Labels are kept in the
+// wallet config rather than the tx index so they survive rescans.
+func (wallet *Wallet) GetTxLabel(txHash string) string {
+	wallet.txLabelsMu.Lock()
+	defer wallet.txLabelsMu.Unlock()
+	return wallet.readTxLabels()[txHash]
+}
+
+// TxLabelsMap returns the labels of all labelled transactions keyed by tx hash.
+// A transaction whose label was cleared maps to an empty label.
+func (wallet *Wallet) TxLabelsMap() map[string]string {
+	wallet.txLabelsMu.Lock()
+	defer wallet.txLabelsMu.Unlock()
+	return wallet.readTxLabels()
+}
+
+// SetTxLabel labels the transaction with the provided hash. An empty label is
+// kept as an explicit override so that clearing a label also hides the
+// description set when the transaction was sent.
+func (wallet *Wallet) SetTxLabel(txHash, label string) error {
+	label = strings.TrimSpace(label)
+	if utf8.RuneCountInString(label) > MaxTxLabelLength {
+		return fmt.Errorf("transaction label exceeds %d characters", MaxTxLabelLength)
+	}
+
+	wallet.txLabelsMu.Lock()
+	defer wallet.txLabelsMu.Unlock()
+
+	labels := wallet.readTxLabels()
+	labels[txHash] = label
+	return wallet.walletConfigSave(TxLabelsConfigKey, labels)
+}
+
+// readTxLabels reads the persisted labels. wallet.txLabelsMu MUST be held.
+func (wallet *Wallet) readTxLabels() map[string]string {
+	labels := make(map[string]string)
+	_ = wallet.ReadUserConfigValue(TxLabelsConfigKey, &labels)
+	return labels
+}
+
+// ApplyTxLabels replaces the label of the transactions in txs that have one in
+// labels, a map of tx hash to label as returned by TxLabelsMap.
+func ApplyTxLabels(txs []*Transaction, labels map[string]string) {
+	for _, tx := range txs {
+		if label, ok := labels[tx.Hash]; ok {
+			tx.Label = label
+		}
+	}
+}
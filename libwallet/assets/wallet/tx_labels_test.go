@@ -0,0 +1,51 @@
+package wallet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSetTxLabel(t *testing.T) {
+	wallet := testWallet(t)
+
+	if err := wallet.SetTxLabel("tx1", "  rent  "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := wallet.SetTxLabel("tx2", strings.Repeat("a", MaxTxLabelLength+1)); err == nil {
+		t.Fatal("expected an error for a label that is too long")
+	}
+
+	// Labels are read back from the db, not from memory.
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	if label := other.GetTxLabel("tx1"); label != "rent" {
+		t.Fatalf("expected label %q, got %q", "rent", label)
+	}
+	if label := other.GetTxLabel("tx2"); label != "" {
+		t.Fatalf("expected no label, got %q", label)
+	}
+
+	if err := wallet.SetTxLabel("tx1", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if label := other.GetTxLabel("tx1"); label != "" {
+		t.Fatalf("expected the label to be removed, got %q", label)
+	}
+	if label, ok := other.TxLabelsMap()["tx1"]; !ok || label != "" {
+		t.Fatalf("expected an empty label override, got %q, %v", label, ok)
+	}
+}
+
+func TestApplyTxLabels(t *testing.T) {
+	txs := []*Transaction{{Hash: "a", Label: "sent"}, {Hash: "b", Label: "sent"}, {Hash: "c"}}
+	ApplyTxLabels(txs, map[string]string{
+		"a": "rent",
+		"b": "",
+	})
+
+	want := []string{"rent", "", ""}
+	for i, tx := range txs {
+		if tx.Label != want[i] {
+			t.Fatalf("tx %s: expected label %q, got %q", tx.Hash, want[i], tx.Label)
+		}
+	}
+}
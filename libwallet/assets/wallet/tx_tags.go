@@ -0,0 +1,78 @@
+package wallet
+
+import (
+	"fmt"
+)
+
+// Transaction tags categorize transactions for accounting purposes. Unlike tx
+// labels, tags are a fixed set of values which makes them suitable for
+// filtering the transaction history and exports.
+const (
+	TxTagIncome   = "income"
+	TxTagExpense  = "expense"
+	TxTagTransfer = "transfer"
+)
+
+// TxTags lists all the supported transaction tags.
+var TxTags = []string{TxTagIncome, TxTagExpense, TxTagTransfer}
+
+// IsValidTxTag returns true if tag is one of the supported transaction tags.
+func IsValidTxTag(tag string) bool {
+	for _, t := range TxTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// TxTagsMap returns the tags of all tagged transactions keyed by tx hash.
+func (wallet *Wallet) TxTagsMap() map[string]string {
+	wallet.txTagsMu.Lock()
+	defer wallet.txTagsMu.Unlock()
+	return wallet.readTxTags()
+}
+
+// TxTag returns the tag set for the transaction with the provided hash or an
+// empty string if the transaction isn't tagged.
+func (wallet *Wallet) TxTag(txHash string) string {
+	return wallet.TxTagsMap()[txHash]
+}
+
+// SetTxTag tags the transaction with the provided hash. An empty tag removes
+// any tag previously set for the transaction.
+func (wallet *Wallet) SetTxTag(txHash, tag string) error {
+	if tag != "" && !IsValidTxTag(tag) {
+		return fmt.Errorf("invalid transaction tag: %s", tag)
+	}
+
+	wallet.txTagsMu.Lock()
+	defer wallet.txTagsMu.Unlock()
+
+	tags := wallet.readTxTags()
+	if tag == "" {
+		delete(tags, txHash)
+	} else {
+		tags[txHash] = tag
+	}
+	return wallet.walletConfigSave(TxTagsConfigKey, tags)
+}
+
+// readTxTags reads the persisted tags. wallet.txTagsMu MUST be held.
+func (wallet *Wallet) readTxTags() map[string]string {
+	tags := make(map[string]string)
+	_ = wallet.ReadUserConfigValue(TxTagsConfigKey, &tags)
+	return tags
+}
+
+// FilterTxsByTag returns the transactions in txs that have the provided tag
+// in tags, a map of tx hash to tag as returned by TxTagsMap.
+func FilterTxsByTag(txs []*Transaction, tags map[string]string, tag string) []*Transaction {
+	filtered := make([]*Transaction, 0, len(txs))
+	for _, tx := range txs {
+		if tags[tx.Hash] == tag {
+			filtered = append(filtered, tx)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,95 @@
+package wallet
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/asdine/storm"
+)
+
+func testWallet(t *testing.T) *Wallet {
+	t.Helper()
+	db, err := storm.Open(filepath.Join(t.TempDir(), "wallets.db"))
+	if err != nil {
+		t.Fatalf("storm.Open error: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return &Wallet{ID: 1, db: db}
+}
+
+func TestSetTxTag(t *testing.T) {
+	wallet := testWallet(t)
+
+	if err := wallet.SetTxTag("tx1", TxTagIncome); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := wallet.SetTxTag("tx2", TxTagExpense); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := wallet.SetTxTag("tx3", "groceries"); err == nil {
+		t.Fatal("expected an error for an unsupported tag")
+	}
+
+	// Tags are read back from the db, not from memory.
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	if tag := other.TxTag("tx1"); tag != TxTagIncome {
+		t.Fatalf("expected tag %q, got %q", TxTagIncome, tag)
+	}
+	if tag := other.TxTag("tx3"); tag != "" {
+		t.Fatalf("expected no tag, got %q", tag)
+	}
+
+	// Tags are stored per wallet.
+	if tag := (&Wallet{ID: 2, db: wallet.db}).TxTag("tx1"); tag != "" {
+		t.Fatalf("expected no tag for another wallet, got %q", tag)
+	}
+
+	// An empty tag clears the tag.
+	if err := wallet.SetTxTag("tx1", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tags := wallet.TxTagsMap()
+	if len(tags) != 1 || tags["tx2"] != TxTagExpense {
+		t.Fatalf("unexpected tags after clearing: %v", tags)
+	}
+}
+
+func TestFilterTxsByTag(t *testing.T) {
+	txs := []*Transaction{{Hash: "a"}, {Hash: "b"}, {Hash: "c"}, {Hash: "d"}}
+	tags := map[string]string{
+		"a": TxTagIncome,
+		"b": TxTagTransfer,
+		"c": TxTagIncome,
+	}
+
+	tests := []struct {
+		name string
+		tag  string
+		want []string
+	}{{
+		name: "income",
+		tag:  TxTagIncome,
+		want: []string{"a", "c"},
+	}, {
+		name: "transfer",
+		tag:  TxTagTransfer,
+		want: []string{"b"},
+	}, {
+		name: "expense",
+		tag:  TxTagExpense,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filtered := FilterTxsByTag(txs, tags, test.tag)
+			if len(filtered) != len(test.want) {
+				t.Fatalf("expected %d txs, got %d", len(test.want), len(filtered))
+			}
+			for i, tx := range filtered {
+				if tx.Hash != test.want[i] {
+					t.Fatalf("expected tx %s at index %d, got %s", test.want[i], i, tx.Hash)
+				}
+			}
+		})
+	}
+}
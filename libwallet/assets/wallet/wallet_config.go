@@ -25,22 +25,35 @@ const (
 
 	IncomingTxNotificationsConfigKey = "tx_notification_enabled"
 	BeepNewBlocksConfigKey           = "beep_new_blocks"
+	WalletNotificationsConfigKey     = "wallet_notifications"
 
 	SyncOnCellularConfigKey             = "always_sync"
 	NetworkModeConfigKey                = "network_mode"
 	SpvPersistentPeerAddressesConfigKey = "spv_peer_addresses"
+	SpvAdditionalPeerAddressesConfigKey = "spv_additional_peer_addresses"
+	BirthdayHeightConfigKey             = "birthday_height"
+	MinFeeRateConfigKey                 = "min_fee_rate"
 	UserAgentConfigKey                  = "user_agent"
 
 	PoliteiaNotificationConfigKey = "politeia_notification"
 
 	LastTxHashConfigKey = "last_tx_hash"
+	TxTagsConfigKey     = "tx_tags"
+	TxLabelsConfigKey   = "tx_labels"
+
+	AddressLabelsConfigKey = "address_labels"
+
+	ExportedTxsConfigKey = "exported_unsigned_txs"
 
 	KnownVSPsConfigKey = "known_vsps"
 
-	TicketBuyerVSPHostConfigKey = "tb_vsp_host"
-	TicketBuyerWalletConfigKey  = "tb_wallet_id"
-	TicketBuyerAccountConfigKey = "tb_account_number"
-	TicketBuyerATMConfigKey     = "tb_amount_to_maintain"
+	TreasuryPolicyHistoryConfigKey = "treasury_policy_history"
+
+	TicketBuyerVSPHostConfigKey    = "tb_vsp_host"
+	TicketBuyerWalletConfigKey     = "tb_wallet_id"
+	TicketBuyerAccountConfigKey    = "tb_account_number"
+	TicketBuyerATMConfigKey        = "tb_amount_to_maintain"
+	TicketBuyerMaxTicketsConfigKey = "tb_max_tickets_per_window"
 
 	ExchangeSourceDstnTypeConfigKey = "exchange_source_destination_key"
 
@@ -55,6 +68,12 @@ const (
 	DarkModeConfigKey                = "dark_mode"
 	HideTotalBalanceConfigKey        = "hideTotalUSDBalance"
 	IsCEXFirstVisitConfigKey         = "is_cex_first_visit"
+	AdvancedModeConfigKey            = "advanced_mode"
+	StartupWalletConfigKey           = "startup_wallet_id"
+	StartupAccountConfigKey          = "startup_account_number"
+	LockOnBackgroundConfigKey        = "lock_on_background"
+	HiddenWalletConfigKey            = "hidden_wallet"
+	DisplayOrderConfigKey            = "display_order"
 
 	PassphraseTypePin  int32 = 0
 	PassphraseTypePass int32 = 1
@@ -195,3 +214,29 @@ func (wallet *Wallet) ReadStringConfigValueForKey(key string, defaultValue strin
 	}
 	return
 }
+
+// IsHidden returns true if the wallet was archived by the user. Hidden wallets
+// aren't listed in wallet selectors unless they ask for them.
+func (wallet *Wallet) IsHidden() bool {
+	return wallet.ReadBoolConfigValueForKey(HiddenWalletConfigKey, false)
+}
+
+// SetHidden archives the wallet or restores an archived wallet.
+func (wallet *Wallet) SetHidden(hidden bool) {
+	wallet.SaveUserConfigValue(HiddenWalletConfigKey, hidden)
+}
+
+// DisplayOrder returns the position of the wallet in the order chosen by the
+// user, -1 if the user didn't order the wallet.
+func (wallet *Wallet) DisplayOrder() int {
+	return wallet.ReadIntConfigValueForKey(DisplayOrderConfigKey, -1)
+}
+
+// SetDisplayOrder saves the position of the wallet in the order chosen by the
+// user. A negative order resets the wallet to the default order.
+func (wallet *Wallet) SetDisplayOrder(order int) {
+	if order < 0 {
+		order = -1
+	}
+	wallet.SetIntConfigValueForKey(DisplayOrderConfigKey, order)
+}
@@ -0,0 +1,43 @@
+package wallet
+
+import "testing"
+
+func TestHiddenWallet(t *testing.T) {
+	wallet := testWallet(t)
+	if wallet.IsHidden() {
+		t.Fatal("expected a new wallet not to be hidden")
+	}
+
+	wallet.SetHidden(true)
+	// The flag is read back from the db, not from memory.
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	if !other.IsHidden() {
+		t.Fatal("expected the wallet to be hidden")
+	}
+	if (&Wallet{ID: wallet.ID + 1, db: wallet.db}).IsHidden() {
+		t.Fatal("expected hiding a wallet not to hide other wallets")
+	}
+
+	wallet.SetHidden(false)
+	if other.IsHidden() {
+		t.Fatal("expected the wallet to be restored")
+	}
+}
+
+func TestDisplayOrder(t *testing.T) {
+	wallet := testWallet(t)
+	if order := wallet.DisplayOrder(); order != -1 {
+		t.Fatalf("expected an unordered wallet, got order %d", order)
+	}
+
+	wallet.SetDisplayOrder(2)
+	other := &Wallet{ID: wallet.ID, db: wallet.db}
+	if order := other.DisplayOrder(); order != 2 {
+		t.Fatalf("expected order 2, got %d", order)
+	}
+
+	wallet.SetDisplayOrder(-5)
+	if order := other.DisplayOrder(); order != -1 {
+		t.Fatalf("expected a negative order to reset the wallet, got %d", order)
+	}
+}
@@ -61,6 +61,16 @@ type Wallet struct {
 	cancelFuncs  []context.CancelFunc
 
 	mu sync.RWMutex
+
+	// txTagsMu serializes updates of the persisted transaction tags.
+	txTagsMu sync.Mutex
+	// txLabelsMu serializes updates of the persisted transaction labels.
+	txLabelsMu sync.Mutex
+	// addressLabelsMu serializes updates of the persisted address labels.
+	addressLabelsMu sync.Mutex
+	// exportedTxsMu serializes updates of the persisted unsigned
+	// transactions exported for offline signing.
+	exportedTxsMu sync.Mutex
 }
 
 // prepare gets a wallet ready for use by opening the transactions index database
@@ -653,6 +663,13 @@ func (wallet *Wallet) IsWatchingOnlyWallet() bool {
 	return false
 }
 
+// CanSign returns true if the wallet holds the private keys needed to sign
+// transactions. Watching-only wallets can only view their balance and
+// transactions.
+func (wallet *Wallet) CanSign() bool {
+	return !wallet.IsWatchingOnlyWallet()
+}
+
 func (wallet *Wallet) OpenWallet() error {
 	pubPass := []byte(w.InsecurePubPassphrase)
 	ctx, _ := wallet.ShutdownContextWithCancel()
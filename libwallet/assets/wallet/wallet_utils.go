@@ -304,6 +304,30 @@ func Balances(w Asset) (AssetAmount, AssetAmount, error) {
 	return w.ToAmount(totalSpendable), w.ToAmount(totalBalance), nil
 }
 
+// CanTransact returns true if the wallet can sign and broadcast transactions.
+// If it can't, the reason is returned as one of the utils.ErrWalletIsWatchOnly,
+// utils.ErrNotConnected and utils.ErrNotSynced errors so it can be translated
+// for display.
+func CanTransact(w Asset) (bool, string) {
+	if !w.CanSign() {
+		return false, utils.ErrWalletIsWatchOnly
+	}
+	return CanBroadcast(w)
+}
+
+// CanBroadcast returns true if the wallet can broadcast transactions, which
+// doesn't need it to sign them. A watch-only wallet can broadcast a
+// transaction signed offline.
+func CanBroadcast(w Asset) (bool, string) {
+	switch {
+	case !w.IsConnectedToNetwork():
+		return false, utils.ErrNotConnected
+	case !w.IsSynced() || w.IsRescanning():
+		return false, utils.ErrNotSynced
+	}
+	return true, ""
+}
+
 // SortTxs is a shared function that sorts the provided txs slice in ascending
 // or descending order depending on newestFirst.
 func SortTxs(txs []*Transaction, newestFirst bool) {
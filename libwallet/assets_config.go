@@ -293,6 +293,75 @@ func (mgr *AssetsManager) SetTotalBalanceVisibility(data bool) {
 	mgr.SaveAppConfigValue(sharedW.HideTotalBalanceConfigKey, data)
 }
 
+// IsAdvancedModeOn checks if the advanced mode is set. Advanced mode reveals
+// power-user features such as manual fee rates and coin control.
+func (mgr *AssetsManager) IsAdvancedModeOn() bool {
+	var data bool
+	mgr.ReadAppConfigValue(sharedW.AdvancedModeConfigKey, &data)
+	return data
+}
+
+// SetAdvancedMode sets the advanced mode for the app.
+func (mgr *AssetsManager) SetAdvancedMode(data bool) {
+	mgr.SaveAppConfigValue(sharedW.AdvancedModeConfigKey, data)
+}
+
+// IsLockOnBackgroundOn returns true if all wallets should be locked when the
+// app is minimized or sent to the background.
+func (mgr *AssetsManager) IsLockOnBackgroundOn() bool {
+	var data bool
+	mgr.ReadAppConfigValue(sharedW.LockOnBackgroundConfigKey, &data)
+	return data
+}
+
+// SetLockOnBackground sets whether all wallets should be locked when the app
+// is minimized or sent to the background.
+func (mgr *AssetsManager) SetLockOnBackground(data bool) {
+	mgr.SaveAppConfigValue(sharedW.LockOnBackgroundConfigKey, data)
+}
+
+// SetStartupWallet sets the wallet and account that are selected by default
+// when the app is launched. An account of -1 selects the wallet's default
+// account.
+func (mgr *AssetsManager) SetStartupWallet(walletID int, account int32) {
+	mgr.SaveAppConfigValue(sharedW.StartupWalletConfigKey, walletID)
+	mgr.SaveAppConfigValue(sharedW.StartupAccountConfigKey, account)
+}
+
+// ClearStartupWallet removes the startup wallet preference.
+func (mgr *AssetsManager) ClearStartupWallet() {
+	mgr.appConfigDelete(sharedW.StartupWalletConfigKey)
+	mgr.appConfigDelete(sharedW.StartupAccountConfigKey)
+}
+
+// IsStartupWalletSet checks if a startup wallet has been configured.
+func (mgr *AssetsManager) IsStartupWalletSet() bool {
+	walletID := -1
+	mgr.ReadAppConfigValue(sharedW.StartupWalletConfigKey, &walletID)
+	return walletID != -1
+}
+
+// StartupWallet returns the wallet and account number that should be selected
+// by default on launch. The first wallet and account -1 are returned if no
+// startup wallet is set or the configured wallet no longer exists. A nil wallet
+// is returned if there are no wallets.
+func (mgr *AssetsManager) StartupWallet() (sharedW.Asset, int32) {
+	walletID := -1
+	account := int32(-1)
+	mgr.ReadAppConfigValue(sharedW.StartupWalletConfigKey, &walletID)
+	mgr.ReadAppConfigValue(sharedW.StartupAccountConfigKey, &account)
+
+	if wallet := mgr.WalletWithID(walletID); wallet != nil {
+		return wallet, account
+	}
+
+	wallets := mgr.AllWallets()
+	if len(wallets) == 0 {
+		return nil, -1
+	}
+	return wallets[0], -1
+}
+
 func genKey(prefix, identifier interface{}) string {
 	return fmt.Sprintf("%v-%v", prefix, identifier)
 }
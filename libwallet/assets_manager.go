@@ -16,6 +16,7 @@ import (
 	"github.com/asdine/storm/q"
 	"github.com/crypto-power/cryptopower/appos"
 	"github.com/crypto-power/cryptopower/dexc"
+	"github.com/crypto-power/cryptopower/libwallet/explorer"
 	"github.com/crypto-power/cryptopower/libwallet/ext"
 	"github.com/crypto-power/cryptopower/libwallet/instantswap"
 	"github.com/crypto-power/cryptopower/libwallet/internal/politeia"
@@ -71,6 +72,10 @@ type AssetsManager struct {
 	RateSource      ext.RateSource
 	rateMutex       sync.Mutex
 
+	// displayOrders caches the display order of the wallets, it is read
+	// every time the wallets are listed.
+	displayOrders displayOrderCache
+
 	dexcMtx     sync.RWMutex
 	dexcCtx     context.Context
 	dexc        DEXClient
@@ -491,7 +496,92 @@ func (mgr *AssetsManager) sortWallets(assetType utils.AssetType) []sharedW.Asset
 		return watchOnlyWallets[i].GetWalletID() < watchOnlyWallets[j].GetWalletID()
 	})
 
-	return append(normalWallets, watchOnlyWallets...)
+	wallets := append(normalWallets, watchOnlyWallets...)
+	mgr.sortByDisplayOrder(wallets)
+	return wallets
+}
+
+// displayOrderCache keeps the display order of the wallets in memory so that
+// listing the wallets doesn't read it from the db every time.
+type displayOrderCache struct {
+	mtx    sync.Mutex
+	orders map[int]int
+}
+
+// get returns the display order of the wallets keyed by wallet ID. The orders
+// that aren't cached yet are read from the wallets.
+func (c *displayOrderCache) get(wallets []sharedW.Asset) map[int]int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.orders == nil {
+		c.orders = make(map[int]int)
+	}
+	orders := make(map[int]int, len(wallets))
+	for _, wallet := range wallets {
+		order, ok := c.orders[wallet.GetWalletID()]
+		if !ok {
+			order = wallet.DisplayOrder()
+			c.orders[wallet.GetWalletID()] = order
+		}
+		orders[wallet.GetWalletID()] = order
+	}
+	return orders
+}
+
+// invalidate drops the cached orders so they are read again.
+func (c *displayOrderCache) invalidate() {
+	c.mtx.Lock()
+	c.orders = nil
+	c.mtx.Unlock()
+}
+
+// sortByDisplayOrder moves the wallets ordered by the user first, in the order
+// chosen. The other wallets keep their current order.
+func (mgr *AssetsManager) sortByDisplayOrder(wallets []sharedW.Asset) {
+	orders := mgr.displayOrders.get(wallets)
+	sort.SliceStable(wallets, func(i, j int) bool {
+		return displayOrderLess(orders[wallets[i].GetWalletID()], orders[wallets[j].GetWalletID()])
+	})
+}
+
+// displayOrderLess reports whether a wallet with display order a is listed
+// before a wallet with display order b. Negative orders are unset and listed
+// after the wallets ordered by the user.
+func displayOrderLess(a, b int) bool {
+	if a < 0 {
+		return false
+	}
+	return b < 0 || a < b
+}
+
+// SetWalletOrder saves the order the wallets are listed in. walletIDs holds the
+// IDs of the wallets in the order chosen, wallets that aren't included are
+// listed after them in the default order.
+func (mgr *AssetsManager) SetWalletOrder(walletIDs []int) {
+	orders := make(map[int]int, len(walletIDs))
+	for i, walletID := range walletIDs {
+		orders[walletID] = i
+	}
+	for _, wallet := range mgr.AllWallets() {
+		order, ok := orders[wallet.GetWalletID()]
+		if !ok {
+			order = -1
+		}
+		wallet.SetDisplayOrder(order)
+	}
+	mgr.displayOrders.invalidate()
+}
+
+// HasCustomWalletOrder returns true if the user chose the order the wallets
+// are listed in.
+func (mgr *AssetsManager) HasCustomWalletOrder() bool {
+	wallets := mgr.AllWallets()
+	for _, order := range mgr.displayOrders.get(wallets) {
+		if order >= 0 {
+			return true
+		}
+	}
+	return false
 }
 
 // AllDCRWallets returns all DCR wallets in the assets manager.
@@ -514,6 +604,7 @@ func (mgr *AssetsManager) AllWallets() (wallets []sharedW.Asset) {
 	wallets = mgr.AllDCRWallets()
 	wallets = append(wallets, mgr.AllBTCWallets()...)
 	wallets = append(wallets, mgr.AllLTCWallets()...)
+	mgr.sortByDisplayOrder(wallets)
 	return wallets
 }
 
@@ -536,6 +627,7 @@ func (mgr *AssetsManager) DeleteWallet(walletID int, privPass string) error {
 	case utils.LTCWalletAsset:
 		delete(mgr.Assets.LTC.Wallets, walletID)
 	}
+	mgr.displayOrders.invalidate()
 
 	return nil
 }
@@ -569,9 +661,10 @@ func (mgr *AssetsManager) AssetWallets(assetTypes ...utils.AssetType) []sharedW.
 	}
 
 	if len(wallets) == 0 && len(assetTypes) == 0 {
-		wallets = mgr.AllWallets()
+		return mgr.AllWallets()
 	}
 
+	mgr.sortByDisplayOrder(wallets)
 	return wallets
 }
 
@@ -793,6 +886,16 @@ func (mgr *AssetsManager) BlockExplorerURLForTx(assetType utils.AssetType, txHas
 	return ""
 }
 
+// ExplorerClient returns the block explorer client used to look up address
+// history for the specified asset. An error is returned if the user hasn't
+// allowed the use of block explorer APIs.
+func (mgr *AssetsManager) ExplorerClient(assetType utils.AssetType) (explorer.ExplorerClient, error) {
+	if !mgr.IsHTTPAPIPrivacyModeOff(utils.ExplorerAPI) {
+		return nil, errors.E(utils.ErrUnavailable, "block explorer API is disabled")
+	}
+	return explorer.NewClient(assetType, mgr.NetType())
+}
+
 func (mgr *AssetsManager) LogFile() string {
 	return filepath.Join(mgr.params.LogDir, LogFilename)
 }
@@ -0,0 +1,80 @@
+package libwallet
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+)
+
+func TestDisplayOrderLess(t *testing.T) {
+	// Each wallet is identified by its position in the default order and
+	// holds its display order, -1 if the user didn't order it.
+	type wallet struct {
+		id    int
+		order int
+	}
+	wallets := []wallet{{1, -1}, {2, 1}, {3, -1}, {4, 0}, {5, 2}, {6, -1}}
+	sort.SliceStable(wallets, func(i, j int) bool {
+		return displayOrderLess(wallets[i].order, wallets[j].order)
+	})
+
+	ids := make([]int, 0, len(wallets))
+	for _, w := range wallets {
+		ids = append(ids, w.id)
+	}
+	// The ordered wallets are listed first, followed by the unordered
+	// wallets in their default order.
+	if want := []int{4, 2, 5, 1, 3, 6}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("expected order %v, got %v", want, ids)
+	}
+}
+
+// orderedWallet only implements the wallet ID and display order of the asset
+// interface. It counts the display order reads.
+type orderedWallet struct {
+	sharedW.Asset
+	id    int
+	order int
+	reads int
+}
+
+func (w *orderedWallet) GetWalletID() int {
+	return w.id
+}
+
+func (w *orderedWallet) DisplayOrder() int {
+	w.reads++
+	return w.order
+}
+
+func TestDisplayOrderCache(t *testing.T) {
+	w1, w2 := &orderedWallet{id: 1, order: -1}, &orderedWallet{id: 2, order: 0}
+	var cache displayOrderCache
+
+	for i := 0; i < 3; i++ {
+		orders := cache.get([]sharedW.Asset{w1, w2})
+		if orders[1] != -1 || orders[2] != 0 {
+			t.Fatalf("unexpected orders %v", orders)
+		}
+	}
+	if w1.reads != 1 || w2.reads != 1 {
+		t.Fatalf("expected a single read per wallet, got %d and %d", w1.reads, w2.reads)
+	}
+
+	// A wallet that isn't cached yet is read without reading the others.
+	w3 := &orderedWallet{id: 3, order: 1}
+	if orders := cache.get([]sharedW.Asset{w1, w2, w3}); orders[3] != 1 {
+		t.Fatalf("unexpected orders %v", orders)
+	}
+	if w1.reads != 1 || w3.reads != 1 {
+		t.Fatalf("expected the new wallet only to be read, got %d and %d", w1.reads, w3.reads)
+	}
+
+	w1.order = 2
+	cache.invalidate()
+	if orders := cache.get([]sharedW.Asset{w1}); orders[1] != 2 {
+		t.Fatalf("expected the order to be read again, got %v", orders)
+	}
+}
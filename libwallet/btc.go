@@ -40,8 +40,10 @@ func (mgr *AssetsManager) CreateNewBTCWallet(walletName, privatePassphrase strin
 }
 
 // CreateNewBTCWatchOnlyWallet creates a new BTC watch only wallet and returns it.
-func (mgr *AssetsManager) CreateNewBTCWatchOnlyWallet(walletName, extendedPublicKey string) (sharedW.Asset, error) {
-	wallet, err := btc.CreateWatchOnlyWallet(walletName, extendedPublicKey, mgr.params)
+// The initial sync scans the chain from birthdayHeight, or from the genesis
+// block if birthdayHeight is 0.
+func (mgr *AssetsManager) CreateNewBTCWatchOnlyWallet(walletName, extendedPublicKey string, birthdayHeight int32) (sharedW.Asset, error) {
+	wallet, err := btc.CreateWatchOnlyWallet(walletName, extendedPublicKey, mgr.params, birthdayHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,88 @@
+package explorer
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/url"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// getJSON fetches apiURL and decodes the JSON response into resp. The request
+// is abandoned as soon as ctx is done or the http clients are shut down.
+func getJSON(ctx context.Context, apiURL string, resp interface{}) error {
+	req := &utils.ReqConfig{
+		Method:  http.MethodGet,
+		HTTPURL: apiURL,
+		Headers: http.Header{"Accept": []string{"application/json"}},
+	}
+	_, err := utils.HTTPRequestWithContext(ctx, req, resp)
+	return err
+}
+
+// dcrdataClient looks up DCR addresses using the dcrdata API.
+type dcrdataClient struct {
+	apiURL string
+}
+
+func newDcrdataClient(apiURL string) *dcrdataClient {
+	return &dcrdataClient{apiURL: apiURL}
+}
+
+func (c *dcrdataClient) Host() string {
+	return hostOf(c.apiURL)
+}
+
+func (c *dcrdataClient) AddressHistory(ctx context.Context, address string) (*AddressHistory, error) {
+	var resp struct {
+		NumSpent   int64   `json:"num_spent"`
+		NumUnspent int64   `json:"num_unspent"`
+		DCRSpent   float64 `json:"dcr_spent"`
+		DCRUnspent float64 `json:"dcr_unspent"`
+	}
+	if err := getJSON(ctx, c.apiURL+"/address/"+url.PathEscape(address)+"/totals", &resp); err != nil {
+		return nil, err
+	}
+
+	return &AddressHistory{
+		Address:       address,
+		TxCount:       resp.NumSpent + resp.NumUnspent,
+		TotalReceived: int64(math.Round((resp.DCRSpent + resp.DCRUnspent) * 1e8)),
+	}, nil
+}
+
+// esploraClient looks up BTC and LTC addresses using an Esplora compatible
+// API.
+type esploraClient struct {
+	apiURL string
+}
+
+func newEsploraClient(apiURL string) *esploraClient {
+	return &esploraClient{apiURL: apiURL}
+}
+
+func (c *esploraClient) Host() string {
+	return hostOf(c.apiURL)
+}
+
+type esploraStats struct {
+	FundedTxoSum int64 `json:"funded_txo_sum"`
+	TxCount      int64 `json:"tx_count"`
+}
+
+func (c *esploraClient) AddressHistory(ctx context.Context, address string) (*AddressHistory, error) {
+	var resp struct {
+		ChainStats   esploraStats `json:"chain_stats"`
+		MempoolStats esploraStats `json:"mempool_stats"`
+	}
+	if err := getJSON(ctx, c.apiURL+"/address/"+url.PathEscape(address), &resp); err != nil {
+		return nil, err
+	}
+
+	return &AddressHistory{
+		Address:       address,
+		TxCount:       resp.ChainStats.TxCount + resp.MempoolStats.TxCount,
+		TotalReceived: resp.ChainStats.FundedTxoSum + resp.MempoolStats.FundedTxoSum,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestEsploraAddressHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/address/addr1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"chain_stats":{"funded_txo_sum":5000,"tx_count":2},"mempool_stats":{"funded_txo_sum":100,"tx_count":1}}`))
+	}))
+	defer server.Close()
+
+	history, err := newEsploraClient(server.URL).AddressHistory(context.Background(), "addr1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if history.TxCount != 3 || history.TotalReceived != 5100 {
+		t.Fatalf("unexpected history %+v", history)
+	}
+
+	if _, err := newEsploraClient(server.URL).AddressHistory(context.Background(), "unknown"); err == nil {
+		t.Fatal("expected an error for a non 200 response")
+	}
+}
+
+func TestAddressHistoryTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := newDcrdataClient(server.URL).AddressHistory(ctx, "addr1")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("lookup was not abandoned on timeout, took %v", elapsed)
+	}
+}
+
+func TestAddressHistoryShutdown(t *testing.T) {
+	release := make(chan struct{})
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first request succeeds so the shared client is kept for the
+		// host, the next one blocks until it is abandoned.
+		if requests.Add(1) == 1 {
+			w.Write([]byte(`{}`))
+			return
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := newEsploraClient(server.URL)
+	if _, err := client.AddressHistory(context.Background(), "addr1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := client.AddressHistory(context.Background(), "addr1")
+		errCh <- err
+	}()
+	for requests.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	utils.ShutdownHTTPClients()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("lookup was not abandoned on shutdown")
+	}
+}
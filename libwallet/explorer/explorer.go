@@ -0,0 +1,99 @@
+// Package explorer looks up the on-chain history of addresses using external
+// block explorer services. It allows users to confirm that a restored wallet
+// owns funded addresses before the wallet completes a full sync. Using it
+// reveals the looked up addresses to the explorer service, so callers must
+// gate it behind the ExplorerAPI privacy preference.
+package explorer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// AddressHistory summarizes the history of an address as reported by a block
+// explorer.
+type AddressHistory struct {
+	Address string
+	// TxCount is the number of confirmed and unconfirmed transactions that
+	// involve the address.
+	TxCount int64
+	// TotalReceived is the sum, in atoms, of the outputs paying to the
+	// address.
+	TotalReceived int64
+}
+
+// HasHistory returns true if the address was involved in any transaction.
+func (h *AddressHistory) HasHistory() bool {
+	return h.TxCount > 0
+}
+
+// ExplorerClient looks up address history from a block explorer service.
+type ExplorerClient interface {
+	// Host returns the host of the explorer service so users can be told
+	// which external service their addresses are shared with.
+	Host() string
+	// AddressHistory returns the history of the provided address.
+	AddressHistory(ctx context.Context, address string) (*AddressHistory, error)
+}
+
+// VerifyAddresses looks up the provided addresses in order and returns the
+// history of the first one that has any. A nil history is returned if none of
+// the addresses has history.
+func VerifyAddresses(ctx context.Context, client ExplorerClient, addresses ...string) (*AddressHistory, error) {
+	for _, address := range addresses {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		history, err := client.AddressHistory(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("%s lookup of %s failed: %w", client.Host(), address, err)
+		}
+		if history.HasHistory() {
+			return history, nil
+		}
+	}
+	return nil, nil
+}
+
+// NewClient returns the default explorer client for the provided asset and
+// network. An error is returned if no explorer is known for them.
+func NewClient(assetType utils.AssetType, net utils.NetworkType) (ExplorerClient, error) {
+	isMainnet := net == utils.Mainnet
+	if !isMainnet && net != utils.Testnet {
+		return nil, fmt.Errorf("no block explorer available on %s", net)
+	}
+
+	switch assetType {
+	case utils.DCRWalletAsset:
+		if isMainnet {
+			return newDcrdataClient("https://explorer.dcrdata.org/api"), nil
+		}
+		return newDcrdataClient("https://testnet.dcrdata.org/api"), nil
+
+	case utils.BTCWalletAsset:
+		if isMainnet {
+			return newEsploraClient("https://blockstream.info/api"), nil
+		}
+		return newEsploraClient("https://blockstream.info/testnet/api"), nil
+
+	case utils.LTCWalletAsset:
+		if isMainnet {
+			return newEsploraClient("https://litecoinspace.org/api"), nil
+		}
+		return newEsploraClient("https://litecoinspace.org/testnet/api"), nil
+	}
+
+	return nil, fmt.Errorf("no block explorer available for %s", assetType)
+}
+
+func hostOf(apiURL string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return apiURL
+	}
+	return u.Host
+}
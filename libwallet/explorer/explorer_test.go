@@ -0,0 +1,143 @@
+package explorer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// stubClient serves address histories from memory.
+type stubClient struct {
+	txCounts map[string]int64
+	err      error
+	lookups  []string
+}
+
+func (c *stubClient) Host() string {
+	return "explorer.test"
+}
+
+func (c *stubClient) AddressHistory(_ context.Context, address string) (*AddressHistory, error) {
+	c.lookups = append(c.lookups, address)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &AddressHistory{Address: address, TxCount: c.txCounts[address]}, nil
+}
+
+func TestVerifyAddresses(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      *stubClient
+		addresses   []string
+		want        string
+		wantLookups int
+		wantErr     bool
+	}{{
+		name:        "first address has history",
+		client:      &stubClient{txCounts: map[string]int64{"a": 2}},
+		addresses:   []string{"a", "b"},
+		want:        "a",
+		wantLookups: 1,
+	}, {
+		name:        "later address has history",
+		client:      &stubClient{txCounts: map[string]int64{"c": 1}},
+		addresses:   []string{"a", "b", "c"},
+		want:        "c",
+		wantLookups: 3,
+	}, {
+		name:        "no address has history",
+		client:      &stubClient{},
+		addresses:   []string{"a", "b"},
+		wantLookups: 2,
+	}, {
+		name:        "lookup error",
+		client:      &stubClient{err: errors.New("unreachable")},
+		addresses:   []string{"a", "b"},
+		wantLookups: 1,
+		wantErr:     true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history, err := VerifyAddresses(context.Background(), test.client, test.addresses...)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if len(test.client.lookups) != test.wantLookups {
+				t.Fatalf("expected %d lookups, got %d", test.wantLookups, len(test.client.lookups))
+			}
+
+			var got string
+			if history != nil {
+				got = history.Address
+			}
+			if got != test.want {
+				t.Fatalf("expected address %q with history, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestVerifyAddressesCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	client := &stubClient{txCounts: map[string]int64{"a": 1}}
+	if _, err := VerifyAddresses(ctx, client, "a"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(client.lookups) != 0 {
+		t.Fatalf("expected no lookups, got %d", len(client.lookups))
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetType utils.AssetType
+		net       utils.NetworkType
+		wantHost  string
+		wantErr   bool
+	}{{
+		name:      "dcr mainnet",
+		assetType: utils.DCRWalletAsset,
+		net:       utils.Mainnet,
+		wantHost:  "explorer.dcrdata.org",
+	}, {
+		name:      "btc testnet",
+		assetType: utils.BTCWalletAsset,
+		net:       utils.Testnet,
+		wantHost:  "blockstream.info",
+	}, {
+		name:      "ltc mainnet",
+		assetType: utils.LTCWalletAsset,
+		net:       utils.Mainnet,
+		wantHost:  "litecoinspace.org",
+	}, {
+		name:      "regnet",
+		assetType: utils.DCRWalletAsset,
+		net:       utils.Regression,
+		wantErr:   true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, err := NewClient(test.assetType, test.net)
+			if test.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if client.Host() != test.wantHost {
+				t.Fatalf("expected host %q, got %q", test.wantHost, client.Host())
+			}
+		})
+	}
+}
@@ -135,7 +135,7 @@ func getProposalType(payload string) ProposalType {
 	prop := ProposalTypeNormal
 	decodedBytes, err := base64.StdEncoding.DecodeString(payload)
 	if err != nil {
-		fmt.Println("error decoding proposal metadata:", err)
+		log.Errorf("error decoding proposal metadata: %v", err)
 		return prop
 	}
 
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"strconv"
+)
+
+// atomsPerCoin is the number of atoms (DCR) or satoshis (BTC, LTC) in a coin.
+// All the supported assets use 8 decimal places.
+const atomsPerCoin = 1e8
+
+// FormatAmount formats an amount in atoms (or satoshis) of the asset as coins
+// followed by the asset's ticker, e.g. "1.5 BTC". Trailing zeros are trimmed
+// as done by the assets' amount types.
+func FormatAmount(assetType AssetType, atoms int64) string {
+	coins := strconv.FormatFloat(float64(atoms)/atomsPerCoin, 'f', -1, 64)
+	return coins + " " + assetType.String()
+}
@@ -0,0 +1,28 @@
+package utils
+
+import "testing"
+
+func TestFormatAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetType AssetType
+		atoms     int64
+		want      string
+	}{
+		{name: "zero dcr", assetType: DCRWalletAsset, atoms: 0, want: "0 DCR"},
+		{name: "whole dcr", assetType: DCRWalletAsset, atoms: 2e8, want: "2 DCR"},
+		{name: "fractional dcr", assetType: DCRWalletAsset, atoms: 150000000, want: "1.5 DCR"},
+		{name: "one atom", assetType: DCRWalletAsset, atoms: 1, want: "0.00000001 DCR"},
+		{name: "whole btc", assetType: BTCWalletAsset, atoms: 1e8, want: "1 BTC"},
+		{name: "fractional btc", assetType: BTCWalletAsset, atoms: 12345678, want: "0.12345678 BTC"},
+		{name: "negative btc", assetType: BTCWalletAsset, atoms: -50000, want: "-0.0005 BTC"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FormatAmount(test.assetType, test.atoms); got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
@@ -56,12 +56,23 @@ var (
 	ErrDCRNotInitialized = errors.New("dcr asset not initialized")
 	ErrLTCNotInitialized = errors.New("ltc asset not initialized")
 
+	// ErrChainClientNotStarted is returned by operations that need the
+	// wallet to be connected to the network before it has started syncing.
+	ErrChainClientNotStarted = errors.New("chain client not started")
+
 	ErrUnsupporttedIPV6Address = errors.New("IPv6 addresses unsupportted by the current network")
 	ErrNetConnectionTimeout    = errors.New("Timeout on network connection")
 	ErrPeerConnectionRejected  = errors.New("Peer connection rejected")
 	ErrStakingAccountsMissing  = errors.New("Mixing and Unmixing Accounts are not set")
 
 	ErrTicketPurchaseAccMissing = errors.New("ticket purchase account is not set")
+
+	// ErrNoUnsignedTx is returned when a signed transaction is imported but
+	// no unsigned transaction was created to check it against.
+	ErrNoUnsignedTx = errors.New("no unsigned transaction was created")
+	// ErrSignedTxMismatch is returned when an imported signed transaction
+	// doesn't spend the inputs to the outputs of the unsigned transaction.
+	ErrSignedTxMismatch = errors.New("signed transaction doesn't match the unsigned transaction")
 )
 
 // todo, should update this method to translate more error kinds.
@@ -30,6 +30,7 @@ const (
 	ExchangeHTTPAPI
 	VspAPI
 	UpdateAPI
+	ExplorerAPI
 )
 
 type (
@@ -135,8 +136,9 @@ func (c *Client) getRequestBody(method string, body interface{}) ([]byte, error)
 	return nil, errors.New("invalid request body")
 }
 
-// query prepares and process HTTP request to backend resources.
-func (c *Client) query(reqConfig *ReqConfig) (rawData []byte, resp *http.Response, err error) {
+// query prepares and process HTTP request to backend resources. The request
+// is abandoned when ctx is done.
+func (c *Client) query(ctx context.Context, reqConfig *ReqConfig) (rawData []byte, resp *http.Response, err error) {
 	// package the request body for POST and PUT requests
 	var requestBody []byte
 	if reqConfig.Payload != nil {
@@ -152,7 +154,7 @@ func (c *Client) query(reqConfig *ReqConfig) (rawData []byte, resp *http.Respons
 	}
 
 	// Create http request
-	req, err := http.NewRequestWithContext(c.context, reqConfig.Method, reqConfig.HTTPURL, bytes.NewReader(requestBody))
+	req, err := http.NewRequestWithContext(ctx, reqConfig.Method, reqConfig.HTTPURL, bytes.NewReader(requestBody))
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating http request: %v", err)
 	}
@@ -198,6 +200,12 @@ func (c *Client) query(reqConfig *ReqConfig) (rawData []byte, resp *http.Respons
 // Returned http response body is usually empty because the http stream
 // cannot be read twice.
 func HTTPRequest(reqConfig *ReqConfig, respObj interface{}) (*http.Response, error) {
+	return HTTPRequestWithContext(context.Background(), reqConfig, respObj)
+}
+
+// HTTPRequestWithContext is like HTTPRequest but the request is also
+// abandoned as soon as ctx is done, in addition to ShutdownHTTPClients.
+func HTTPRequestWithContext(ctx context.Context, reqConfig *ReqConfig, respObj interface{}) (*http.Response, error) {
 	// validate the API Url address
 	urlPath, err := url.ParseRequestURI(reqConfig.HTTPURL)
 	if err != nil {
@@ -212,7 +220,12 @@ func HTTPRequest(reqConfig *ReqConfig, respObj interface{}) (*http.Response, err
 	}
 	apiMtx.Unlock()
 
-	body, httpResp, err := client.query(reqConfig)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(client.context, cancel)
+	defer stop()
+
+	body, httpResp, err := client.query(ctx, reqConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// RetryPolicy describes how a failing network call is retried.
+type RetryPolicy struct {
+	// Attempts is the maximum number of calls made.
+	Attempts int
+	// Delay is the wait before the first retry, it doubles after each retry.
+	Delay time.Duration
+	// Timeout bounds the time spent on all the attempts. A call in progress
+	// isn't interrupted, no retry is made once it expires.
+	Timeout time.Duration
+}
+
+// DefaultRetryPolicy retries a call twice, waiting 500ms then 1s, within 30s.
+var DefaultRetryPolicy = RetryPolicy{
+	Attempts: 3,
+	Delay:    500 * time.Millisecond,
+	Timeout:  30 * time.Second,
+}
+
+// Retry calls fn until it succeeds, it fails with an error that isn't
+// transient, the attempts of the policy are used up or ctx is done. The error
+// of the last call is returned if all the calls fail.
+func Retry[T any](ctx context.Context, policy RetryPolicy, fn func() (T, error)) (T, error) {
+	if policy.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Timeout)
+		defer cancel()
+	}
+
+	delay := policy.Delay
+	for attempt := 1; ; attempt++ {
+		res, err := fn()
+		if err == nil || attempt >= policy.Attempts || !IsTransientError(err) {
+			return res, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// IsTransientError returns true if err is a network failure that may not
+// happen again if the call is retried, e.g. a timeout, a dropped connection
+// or a server that is temporarily unavailable.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, ErrNetConnectionTimeout) || errors.Is(err, ErrPeerConnectionRejected) {
+		return true
+	}
+
+	msg := err.Error()
+	switch msg {
+	case ErrNotConnected, ErrUnavailable, ErrNoPeers:
+		return true
+	}
+
+	// HTTPRequest reports the status of unsuccessful responses, server errors
+	// and rate limiting may go away.
+	return strings.Contains(msg, "status: 5") || strings.Contains(msg, "status: 429")
+}
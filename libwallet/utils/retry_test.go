@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+var testRetryPolicy = RetryPolicy{Attempts: 3, Delay: time.Millisecond, Timeout: time.Second}
+
+func TestRetry(t *testing.T) {
+	transientErr := fmt.Errorf("dial: %w", ErrNetConnectionTimeout)
+	permanentErr := errors.New(ErrInvalidPassphrase)
+
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{"success", nil, 1, nil},
+		{"transient then success", []error{transientErr}, 2, nil},
+		{"permanent", []error{permanentErr}, 1, permanentErr},
+		{"attempts used up", []error{transientErr, transientErr, transientErr, transientErr}, 3, transientErr},
+	}
+	for _, test := range tests {
+		calls := 0
+		res, err := Retry(context.Background(), testRetryPolicy, func() (int, error) {
+			calls++
+			if calls <= len(test.errs) {
+				return 0, test.errs[calls-1]
+			}
+			return 42, nil
+		})
+		if !errors.Is(err, test.wantErr) {
+			t.Errorf("%s: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+		if err == nil && res != 42 {
+			t.Errorf("%s: expected result 42, got %d", test.name, res)
+		}
+		if calls != test.wantCalls {
+			t.Errorf("%s: expected %d calls, got %d", test.name, test.wantCalls, calls)
+		}
+	}
+}
+
+func TestRetryStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	_, err := Retry(ctx, RetryPolicy{Attempts: 3, Delay: time.Hour}, func() (int, error) {
+		calls++
+		return 0, ErrNetConnectionTimeout
+	})
+	if !errors.Is(err, ErrNetConnectionTimeout) || calls != 1 {
+		t.Fatalf("expected one failed call, got %d calls and error %v", calls, err)
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{context.Canceled, false},
+		{context.DeadlineExceeded, true},
+		{errors.New(ErrNotConnected), true},
+		{errors.New("error: status: 503 Service Unavailable resp: "), true},
+		{errors.New("error: status: 404 Not Found resp: "), false},
+		{errors.New(ErrWalletLocked), false},
+	}
+	for _, test := range tests {
+		if got := IsTransientError(test.err); got != test.want {
+			t.Errorf("IsTransientError(%v): expected %v, got %v", test.err, test.want, got)
+		}
+	}
+}
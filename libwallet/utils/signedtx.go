@@ -0,0 +1,33 @@
+package utils
+
+// MatchUnsignedTx returns ErrSignedTxMismatch unless a signed transaction
+// spends exactly the inputs of the unsigned transaction it was created from
+// to exactly its outputs. The order of the inputs and outputs isn't checked as
+// signers may sort them. Inputs and outputs are compared by the keys the
+// assets build from them, e.g. the previous outpoint of an input.
+func MatchUnsignedTx[In, Out comparable](signedIns, unsignedIns []In, signedOuts, unsignedOuts []Out) error {
+	if !sameElements(signedIns, unsignedIns) || !sameElements(signedOuts, unsignedOuts) {
+		return ErrSignedTxMismatch
+	}
+	return nil
+}
+
+// sameElements returns true if a and b hold the same elements, as many times
+// each, in any order.
+func sameElements[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[T]int, len(b))
+	for _, e := range b {
+		counts[e]++
+	}
+	for _, e := range a {
+		if counts[e] == 0 {
+			return false
+		}
+		counts[e]--
+	}
+	return true
+}
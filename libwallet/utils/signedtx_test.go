@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatchUnsignedTx(t *testing.T) {
+	unsignedIns := []string{"a:0", "b:1"}
+	unsignedOuts := []string{"100:x", "50:y", "50:y"}
+
+	tests := []struct {
+		name       string
+		signedIns  []string
+		signedOuts []string
+		wantErr    bool
+	}{
+		{"same order", []string{"a:0", "b:1"}, []string{"100:x", "50:y", "50:y"}, false},
+		{"sorted", []string{"b:1", "a:0"}, []string{"50:y", "100:x", "50:y"}, false},
+		{"different input", []string{"a:0", "c:1"}, []string{"100:x", "50:y", "50:y"}, true},
+		{"extra input", []string{"a:0", "b:1", "c:0"}, []string{"100:x", "50:y", "50:y"}, true},
+		{"repeated input", []string{"a:0", "a:0"}, []string{"100:x", "50:y", "50:y"}, true},
+		{"changed output", []string{"a:0", "b:1"}, []string{"100:x", "50:y", "60:y"}, true},
+		{"missing output", []string{"a:0", "b:1"}, []string{"100:x", "50:y"}, true},
+		{"duplicated output", []string{"a:0", "b:1"}, []string{"100:x", "100:x", "50:y"}, true},
+	}
+	for _, test := range tests {
+		err := MatchUnsignedTx(test.signedIns, unsignedIns, test.signedOuts, unsignedOuts)
+		if test.wantErr != errors.Is(err, ErrSignedTxMismatch) {
+			t.Errorf("%s: expected mismatch: %v, got: %v", test.name, test.wantErr, err)
+		}
+	}
+}
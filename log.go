@@ -35,6 +35,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui/page/staking"
 	"github.com/crypto-power/cryptopower/ui/page/transaction"
 	"github.com/crypto-power/cryptopower/ui/page/wallet"
+	"github.com/crypto-power/cryptopower/ui/renderers"
 
 	"decred.org/dcrwallet/v4/p2p"
 	"decred.org/dcrwallet/v4/spv"
@@ -151,6 +152,7 @@ func init() {
 	account.UseLogger(winLog)
 	wallet.UseLogger(winLog)
 	receive.UseLogger(winLog)
+	renderers.UseLogger(winLog)
 
 	logger.New(subsystemSLoggers, subsystemBLoggers)
 	// Neutrino loglevel will always be set to error to control excessive logging.
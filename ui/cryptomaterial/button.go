@@ -24,6 +24,7 @@ type Button struct {
 	label               Label
 	clickable           *widget.Clickable
 	isEnabled           bool
+	isLoading           bool
 	disabledBackground  color.NRGBA
 	disabledTextColor   color.NRGBA
 	HighlightColor      color.NRGBA
@@ -141,6 +142,18 @@ func (b *Button) SetEnabled(enabled bool) {
 	b.isEnabled = enabled
 }
 
+// SetLoading toggles the loading state of the button. A loading button
+// displays a spinner in place of its text and ignores clicks until the
+// loading state is cleared, preventing double submission of async work.
+func (b *Button) SetLoading(loading bool) {
+	b.isLoading = loading
+}
+
+// IsLoading returns true if the button is in the loading state.
+func (b *Button) IsLoading() bool {
+	return b.isLoading
+}
+
 func (b *Button) setDisabledColors() {
 	b.disabledBackground = b.th.Color.Gray3
 	b.disabledTextColor = b.th.Color.Surface
@@ -155,7 +168,7 @@ func (b *Button) DisableHoverColor() {
 }
 
 func (b Button) Clicked(gtx C) bool {
-	return b.clickable.Clicked(gtx)
+	return b.clickable.Clicked(gtx) && !b.isLoading
 }
 
 func (b Button) Hovered() bool {
@@ -175,6 +188,10 @@ func (b *Button) Layout(gtx layout.Context) layout.Dimensions {
 				textColor = b.disabledTextColor
 			}
 
+			if b.isLoading {
+				return b.loaderLayout(gtx, textColor)
+			}
+
 			b.label.Text = b.Text
 			b.label.Font = b.Font
 			b.label.Alignment = text.Middle
@@ -189,6 +206,16 @@ func (b *Button) Layout(gtx layout.Context) layout.Dimensions {
 	})
 }
 
+// loaderLayout draws a spinner sized to match the button's text.
+func (b *Button) loaderLayout(gtx C, col color.NRGBA) D {
+	size := gtx.Sp(b.TextSize)
+	gtx.Constraints.Min = image.Pt(size, size)
+	gtx.Constraints.Max = gtx.Constraints.Min
+	loader := material.Loader(b.th.Base)
+	loader.Color = col
+	return loader.Layout(gtx)
+}
+
 func (b Button) buttonStyleLayout(gtx layout.Context, w layout.Widget) layout.Dimensions {
 	min := gtx.Constraints.Min
 	return layout.Stack{Alignment: layout.Center}.Layout(gtx,
@@ -219,7 +246,7 @@ func (b Button) buttonStyleLayout(gtx layout.Context, w layout.Widget) layout.Di
 			return layout.Center.Layout(gtx, w)
 		}),
 		layout.Expanded(func(gtx C) D {
-			if !b.Enabled() {
+			if !b.Enabled() || b.isLoading {
 				return D{}
 			}
 
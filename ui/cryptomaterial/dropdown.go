@@ -487,6 +487,16 @@ func (d *DropDown) SetItems(items []DropDownItem) {
 	}
 }
 
+// ScrollPosition returns the scroll position of the expanded list of items.
+func (d *DropDown) ScrollPosition() layout.Position {
+	return d.list.Position
+}
+
+// SetScrollPosition scrolls the expanded list of items to pos.
+func (d *DropDown) SetScrollPosition(pos layout.Position) {
+	d.list.Position = pos
+}
+
 func (d *DropDown) ItemsLen() int {
 	return len(d.items)
 }
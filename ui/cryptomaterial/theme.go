@@ -340,6 +340,21 @@ func (t *Theme) AssetIcon(asset utils.AssetType) *Image {
 	return icon
 }
 
+// AssetBadgeColor returns the background color of the badge that identifies
+// the asset.
+func (t *Theme) AssetBadgeColor(asset utils.AssetType) color.NRGBA {
+	switch asset {
+	case utils.DCRWalletAsset:
+		return t.Color.DCRBadge
+	case utils.LTCWalletAsset:
+		return t.Color.LTCBadge
+	case utils.BTCWalletAsset:
+		return t.Color.BTCBadge
+	default:
+		return t.Color.Gray3
+	}
+}
+
 // WatchOnlyAssetIcon returns the icon for a watch only wallet.
 func (t *Theme) WatchOnlyAssetIcon(asset utils.AssetType) *Image {
 	var icon *Image
@@ -0,0 +1,122 @@
+package load
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	uiUtils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// fiatRateTTL is how long a fetched rate is used before it is refreshed.
+const fiatRateTTL = 5 * time.Minute
+
+var (
+	// ErrFiatConversionDisabled is returned by FiatValue if exchange rate
+	// fetching is turned off or no rate source is selected.
+	ErrFiatConversionDisabled = errors.New("fiat conversion is disabled")
+	// ErrFiatRateUnavailable is returned by FiatValue if no rate could be
+	// fetched and none was fetched before.
+	ErrFiatRateUnavailable = errors.New("fiat rate unavailable")
+)
+
+type fiatRate struct {
+	rate      float64
+	fetchedAt time.Time
+}
+
+// fiatRates caches the fiat rates of markets. Callers requesting a market
+// whose rate is being fetched wait for that fetch instead of starting another
+// one, and the last good rate is returned if a refresh fails.
+type fiatRates struct {
+	mtx      sync.Mutex
+	rates    map[values.Market]fiatRate
+	inflight map[values.Market]chan struct{}
+
+	fetch func(market values.Market) *ext.Ticker
+	now   func() time.Time
+}
+
+func newFiatRates(fetch func(market values.Market) *ext.Ticker) *fiatRates {
+	return &fiatRates{
+		rates:    make(map[values.Market]fiatRate),
+		inflight: make(map[values.Market]chan struct{}),
+		fetch:    fetch,
+		now:      time.Now,
+	}
+}
+
+// rate returns the rate of the market, fetching it if it isn't cached or has
+// expired.
+func (f *fiatRates) rate(market values.Market) (float64, error) {
+	f.mtx.Lock()
+	cached, ok := f.rates[market]
+	if ok && f.now().Sub(cached.fetchedAt) < fiatRateTTL {
+		f.mtx.Unlock()
+		return cached.rate, nil
+	}
+
+	if done, fetching := f.inflight[market]; fetching {
+		f.mtx.Unlock()
+		<-done
+		return f.cachedRate(market)
+	}
+
+	done := make(chan struct{})
+	f.inflight[market] = done
+	f.mtx.Unlock()
+
+	ticker := f.fetch(market)
+
+	f.mtx.Lock()
+	if ticker != nil && ticker.LastTradePrice > 0 {
+		f.rates[market] = fiatRate{rate: ticker.LastTradePrice, fetchedAt: f.now()}
+	}
+	delete(f.inflight, market)
+	f.mtx.Unlock()
+	close(done)
+
+	return f.cachedRate(market)
+}
+
+func (f *fiatRates) cachedRate(market values.Market) (float64, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	cached, ok := f.rates[market]
+	if !ok {
+		return 0, ErrFiatRateUnavailable
+	}
+	return cached.rate, nil
+}
+
+// FiatValue returns the fiat value of the provided amount of the asset
+// formatted for display. Rates are fetched from the rate source selected in
+// the currency conversion settings and cached, it may block on a network
+// request and shouldn't be called from a layout.
+func (l *Load) FiatValue(assetType utils.AssetType, atoms int64) (string, error) {
+	if l.AssetsManager == nil || l.AssetsManager.RateSource == nil || !l.AssetsManager.ExchangeRateFetchingEnabled() {
+		return "", ErrFiatConversionDisabled
+	}
+
+	market, err := uiUtils.USDMarketFromAsset(assetType)
+	if err != nil {
+		return "", err
+	}
+
+	l.fiatRatesOnce.Do(func() {
+		l.fiatRates = newFiatRates(func(market values.Market) *ext.Ticker {
+			return l.AssetsManager.RateSource.GetTicker(market, false)
+		})
+	})
+
+	rate, err := l.fiatRates.rate(market)
+	if err != nil {
+		return "", err
+	}
+
+	coin := uiUtils.AssetAmount(assetType, atoms).ToCoin()
+	return uiUtils.FormatAsUSDString(l.Printer, uiUtils.CryptoToUSD(rate, coin)), nil
+}
@@ -0,0 +1,104 @@
+package load
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/ext"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+func TestFiatRatesCache(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	var fetches atomic.Int32
+	var price atomic.Value
+	price.Store(20.0)
+	rates := newFiatRates(func(values.Market) *ext.Ticker {
+		fetches.Add(1)
+		return &ext.Ticker{LastTradePrice: price.Load().(float64)}
+	})
+	rates.now = func() time.Time { return now }
+
+	if rate, err := rates.rate(values.DCRUSDTMarket); err != nil || rate != 20 {
+		t.Fatalf("expected (20, nil), got (%v, %v)", rate, err)
+	}
+
+	// The cached rate is used until it expires.
+	price.Store(25.0)
+	now = now.Add(fiatRateTTL - time.Second)
+	if rate, _ := rates.rate(values.DCRUSDTMarket); rate != 20 || fetches.Load() != 1 {
+		t.Fatalf("expected the cached rate after %d fetch, got %v after %d", 1, rate, fetches.Load())
+	}
+
+	now = now.Add(time.Second)
+	if rate, _ := rates.rate(values.DCRUSDTMarket); rate != 25 || fetches.Load() != 2 {
+		t.Fatalf("expected a refreshed rate of 25, got %v after %d fetches", rate, fetches.Load())
+	}
+}
+
+func TestFiatRatesKeepsLastGoodRate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	var offline atomic.Bool
+	rates := newFiatRates(func(values.Market) *ext.Ticker {
+		if offline.Load() {
+			return nil
+		}
+		return &ext.Ticker{LastTradePrice: 20}
+	})
+	rates.now = func() time.Time { return now }
+
+	if _, err := rates.rate(values.BTCUSDTMarket); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	offline.Store(true)
+	now = now.Add(fiatRateTTL)
+	if rate, err := rates.rate(values.BTCUSDTMarket); err != nil || rate != 20 {
+		t.Fatalf("expected the last good rate, got (%v, %v)", rate, err)
+	}
+
+	if _, err := rates.rate(values.LTCUSDTMarket); err != ErrFiatRateUnavailable {
+		t.Fatalf("expected %v for a market never fetched, got %v", ErrFiatRateUnavailable, err)
+	}
+}
+
+func TestFiatRatesConcurrentCallers(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var fetches atomic.Int32
+	rates := newFiatRates(func(values.Market) *ext.Ticker {
+		if fetches.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		return &ext.Ticker{LastTradePrice: 20}
+	})
+
+	var wg sync.WaitGroup
+	results := make([]float64, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i > 0 {
+				<-started
+			}
+			results[i], _ = rates.rate(values.DCRUSDTMarket)
+		}(i)
+	}
+	<-started
+	// Give the other callers time to find the fetch in progress.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("expected a single fetch, got %d", n)
+	}
+	for i, rate := range results {
+		if rate != 20 {
+			t.Fatalf("caller %d: expected a rate of 20, got %v", i, rate)
+		}
+	}
+}
@@ -6,6 +6,9 @@
 package load
 
 import (
+	"sync"
+	"sync/atomic"
+
 	giouiApp "gioui.org/app"
 	"golang.org/x/text/message"
 
@@ -42,17 +45,35 @@ type Load struct {
 	CurrencySettingChanged func()
 	Device                 *device.Device
 
+	// PendingOperations holds the operations that must be confirmed before
+	// the app is closed or the page that started them is exited.
+	PendingOperations *PendingOperations
+	// walletLockDeferred is true while locking all wallets waits for the
+	// pending operations to complete.
+	walletLockDeferred atomic.Bool
+
+	fiatRates     *fiatRates
+	fiatRatesOnce sync.Once
+
 	// TODO: Kill this property!
 	ToggleSync func(sharedW.Asset, NeedUnlockRestore)
 }
 
 func NewLoad(appInfo *AppInfo, window *giouiApp.Window) *Load {
 	return &Load{
-		AppInfo: appInfo,
-		Device:  device.NewDevice(window),
+		AppInfo:           appInfo,
+		Device:            device.NewDevice(window),
+		PendingOperations: newPendingOperations(),
 	}
 }
 
+// IsAdvancedModeOn returns true if power-user features (manual fee rates, coin
+// control, etc) should be displayed. Layouts of such features should consult
+// this before rendering them.
+func (l *Load) IsAdvancedModeOn() bool {
+	return l.AssetsManager != nil && l.AssetsManager.IsAdvancedModeOn()
+}
+
 func (l *Load) RefreshTheme(window app.WindowNavigator) {
 	isDarkModeOn := l.AssetsManager.IsDarkModeOn()
 	l.Theme.SwitchDarkMode(isDarkModeOn, assets.DecredIcons)
@@ -0,0 +1,79 @@
+package load
+
+import (
+	"sort"
+	"sync"
+)
+
+// PendingOperations tracks operations such as tx broadcasts or exchange orders
+// that should not be interrupted by closing the app or navigating away from
+// the page that started them. Pages register an operation before starting it
+// and remove it once it completes.
+type PendingOperations struct {
+	mtx sync.RWMutex
+	ops map[string]string
+	// idleFns are called once no operation is in-flight.
+	idleFns []func()
+}
+
+func newPendingOperations() *PendingOperations {
+	return &PendingOperations{
+		ops: make(map[string]string),
+	}
+}
+
+// Add registers an in-flight operation with a user facing description. Adding
+// an id that is already registered replaces its description.
+func (p *PendingOperations) Add(id, description string) {
+	p.mtx.Lock()
+	p.ops[id] = description
+	p.mtx.Unlock()
+}
+
+// Remove unregisters the operation with the provided id.
+func (p *PendingOperations) Remove(id string) {
+	p.mtx.Lock()
+	delete(p.ops, id)
+	var idleFns []func()
+	if len(p.ops) == 0 {
+		idleFns, p.idleFns = p.idleFns, nil
+	}
+	p.mtx.Unlock()
+
+	for _, fn := range idleFns {
+		fn()
+	}
+}
+
+// WhenIdle calls fn immediately if no operation is in-flight, otherwise fn is
+// called once the last in-flight operation is removed.
+func (p *PendingOperations) WhenIdle(fn func()) {
+	p.mtx.Lock()
+	if len(p.ops) > 0 {
+		p.idleFns = append(p.idleFns, fn)
+		p.mtx.Unlock()
+		return
+	}
+	p.mtx.Unlock()
+	fn()
+}
+
+// HasPending returns true if at least one operation is in-flight.
+func (p *PendingOperations) HasPending() bool {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	return len(p.ops) > 0
+}
+
+// Descriptions returns the descriptions of all in-flight operations sorted
+// alphabetically.
+func (p *PendingOperations) Descriptions() []string {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	descriptions := make([]string, 0, len(p.ops))
+	for _, description := range p.ops {
+		descriptions = append(descriptions, description)
+	}
+	sort.Strings(descriptions)
+	return descriptions
+}
@@ -0,0 +1,45 @@
+package load
+
+import (
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+)
+
+// LockWalletsOnBackground locks all wallets if the user enabled locking the
+// wallets when the app is minimized or sent to the background.
+func (l *Load) LockWalletsOnBackground() {
+	if l.AssetsManager == nil || !l.AssetsManager.IsLockOnBackgroundOn() {
+		return
+	}
+	l.LockAllWallets()
+}
+
+// LockAllWallets locks every unlocked wallet. Operations registered in
+// PendingOperations may need their wallet unlocked, so locking is deferred
+// until all of them complete.
+func (l *Load) LockAllWallets() {
+	if !l.walletLockDeferred.CompareAndSwap(false, true) {
+		// A lock is already waiting for the pending operations.
+		return
+	}
+
+	l.PendingOperations.WhenIdle(func() {
+		l.walletLockDeferred.Store(false)
+		l.lockAllWallets()
+	})
+}
+
+func (l *Load) lockAllWallets() {
+	for _, wallet := range l.AssetsManager.AllWallets() {
+		if wallet.IsLocked() {
+			continue
+		}
+
+		// The account mixer needs the wallet unlocked for as long as it runs.
+		if dcrWallet, ok := wallet.(*dcr.Asset); ok && dcrWallet.IsAccountMixerActive() {
+			log.Infof("Not locking wallet %s, the account mixer is running", wallet.GetWalletName())
+			continue
+		}
+
+		wallet.LockWallet()
+	}
+}
@@ -3,6 +3,7 @@ package load
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
@@ -55,3 +56,57 @@ func GetAPIFeeRate(w sharedW.Asset) ([]sharedW.FeeEstimate, error) {
 		return nil, fmt.Errorf("(%v) wallet not supported", w.GetAssetType())
 	}
 }
+
+// FeeRate returns the fee rate estimated to get a tx from the provided wallet
+// confirmed within confTarget blocks. Only BTC wallets support estimates.
+func FeeRate(w sharedW.Asset, confTarget int32) (int64, error) {
+	asset, ok := w.(*btc.Asset)
+	if !ok {
+		return 0, fmt.Errorf("(%v) wallet not supported", w.GetAssetType())
+	}
+	return asset.FeeRate(confTarget)
+}
+
+// EstimateConfirmations returns the estimated number of blocks, and the time
+// they take to be mined, a tx from the provided wallet paying feeRate needs to
+// be confirmed. known is false if the wallet doesn't support estimates or no
+// estimate data is available.
+func EstimateConfirmations(w sharedW.Asset, feeRate int64) (blocks int32, eta time.Duration, known bool) {
+	asset, ok := w.(*btc.Asset)
+	if !ok {
+		return 0, 0, false
+	}
+	return asset.EstimateConfirmations(asset.ToAmount(feeRate))
+}
+
+// GetMinFeeRate returns the minimum fee rate allowed for txs sent from the
+// provided wallet.
+func GetMinFeeRate(w sharedW.Asset) (int64, error) {
+	switch asset := w.(type) {
+	case *btc.Asset:
+		return asset.GetMinFeeRate().ToInt(), nil
+	case *ltc.Asset:
+		return asset.GetMinFeeRate().ToInt(), nil
+	default:
+		return 0, fmt.Errorf("(%v) wallet not supported", w.GetAssetType())
+	}
+}
+
+// SetMinFeeRate validates the string input is a number before setting it as
+// the minimum fee rate allowed for txs sent from the provided wallet. It
+// returns the string converted to an int amount.
+func SetMinFeeRate(w sharedW.Asset, feerate string) (int64, error) {
+	rate, err := strconv.ParseInt(feerate, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("(%v) not valid tx fee rate", feerate)
+	}
+
+	switch asset := w.(type) {
+	case *btc.Asset:
+		return rate, asset.SetMinFeeRate(asset.ToAmount(rate))
+	case *ltc.Asset:
+		return rate, asset.SetMinFeeRate(asset.ToAmount(rate))
+	default:
+		return 0, fmt.Errorf("(%v) wallet not supported", w.GetAssetType())
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/widget"
-	"gioui.org/widget/material"
 
 	"github.com/crypto-power/cryptopower/app"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
@@ -39,14 +38,15 @@ type CreatePasswordModal struct {
 
 	parent app.Page
 
-	materialLoader material.LoaderStyle
-
 	customWidget layout.Widget
 
 	// positiveButtonText string
 	btnPositive cryptomaterial.Button
 	// Returns true to dismiss dialog
 	positiveButtonClicked func(walletName, password string, m *CreatePasswordModal) bool
+	// positiveButtonCondition must return true for the positive button to be
+	// enabled, in addition to the editors being valid.
+	positiveButtonCondition func() bool
 
 	// negativeButtonText    string
 	btnNegative           cryptomaterial.Button
@@ -83,8 +83,6 @@ func NewCreatePasswordModal(l *load.Load) *CreatePasswordModal {
 	cm.negativeButtonClicked = func() {}
 	cm.positiveButtonClicked = func(_, _ string, _ *CreatePasswordModal) bool { return true }
 
-	cm.materialLoader = material.Loader(l.Theme.Base)
-
 	return cm
 }
 
@@ -147,6 +145,13 @@ func (cm *CreatePasswordModal) SetPositiveButtonCallback(callback func(walletNam
 	return cm
 }
 
+// SetPositiveButtonCondition sets a condition that must be met, in addition to
+// the password being entered, before the positive button is enabled.
+func (cm *CreatePasswordModal) SetPositiveButtonCondition(condition func() bool) *CreatePasswordModal {
+	cm.positiveButtonCondition = condition
+	return cm
+}
+
 func (cm *CreatePasswordModal) SetNegativeButtonText(text string) *CreatePasswordModal {
 	cm.btnNegative.Text = text
 	return cm
@@ -159,6 +164,7 @@ func (cm *CreatePasswordModal) SetNegativeButtonCallback(callback func()) *Creat
 
 func (cm *CreatePasswordModal) setLoading(loading bool) {
 	cm.isLoading = loading
+	cm.btnPositive.SetLoading(loading)
 	cm.Modal.SetDisabled(loading)
 }
 
@@ -199,7 +205,9 @@ func (cm *CreatePasswordModal) validToCreate() bool {
 		}
 	}
 
-	return nameValid && utils.EditorsNotEmpty(cm.passwordEditor.Editor) && validPassword && passwordsMatch
+	conditionMet := cm.positiveButtonCondition == nil || cm.positiveButtonCondition()
+
+	return nameValid && utils.EditorsNotEmpty(cm.passwordEditor.Editor) && validPassword && passwordsMatch && conditionMet
 }
 
 // SetParent sets the page that created PasswordModal as it's parent.
@@ -239,6 +247,10 @@ func (cm *CreatePasswordModal) Handle(gtx C) {
 				return
 			}
 		}
+
+		if cm.positiveButtonCondition != nil && !cm.positiveButtonCondition() {
+			return
+		}
 		cm.setLoading(true)
 		go func() {
 			if cm.positiveButtonClicked(cm.walletName.Editor.Text(), cm.passwordEditor.Editor.Text(), cm) {
@@ -431,13 +443,7 @@ func (cm *CreatePasswordModal) LayoutComponents() []layout.Widget {
 
 					return cm.btnNegative.Layout(gtx)
 				}),
-				layout.Rigid(func(gtx C) D {
-					if cm.isLoading {
-						return cm.materialLoader.Layout(gtx)
-					}
-
-					return cm.btnPositive.Layout(gtx)
-				}),
+				layout.Rigid(cm.btnPositive.Layout),
 			)
 		})
 	})
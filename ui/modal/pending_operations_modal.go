@@ -0,0 +1,33 @@
+package modal
+
+import (
+	"strings"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// ConfirmPendingOperations calls proceed immediately if no operations are
+// registered as in-flight. Otherwise the user is asked to confirm that the
+// pending operations may be interrupted before proceed is called.
+func ConfirmPendingOperations(l *load.Load, window app.WindowNavigator, proceed func()) {
+	if l.PendingOperations == nil || !l.PendingOperations.HasPending() {
+		proceed()
+		return
+	}
+
+	pending := strings.Join(l.PendingOperations.Descriptions(), ", ")
+	warningModal := NewCustomModal(l).
+		Title(values.String(values.StrPendingOperations)).
+		Body(values.StringF(values.StrPendingOperationsWarning, pending)).
+		SetNegativeButtonText(values.String(values.StrNo)).
+		SetPositiveButtonText(values.String(values.StrYes)).
+		PositiveButtonStyle(l.Theme.Color.Surface, l.Theme.Color.Danger).
+		SetPositiveButtonCallback(func(_ bool, _ *InfoModal) bool {
+			proceed()
+			return true
+		}).
+		SetCancelable(true)
+	window.ShowModal(warningModal)
+}
@@ -18,6 +18,7 @@ type TextInputModal struct {
 	*InfoModal
 
 	isLoading           bool
+	showLoader          bool
 	showAccountWarnInfo bool
 	isCancelable        bool
 
@@ -59,9 +60,20 @@ func (tm *TextInputModal) Hint(hint string) *TextInputModal {
 
 func (tm *TextInputModal) setLoading(loading bool) {
 	tm.isLoading = loading
+	if tm.showLoader {
+		tm.InfoModal.setLoading(loading)
+		return
+	}
 	tm.Modal.SetDisabled(loading)
 }
 
+// ShowLoader swaps the action buttons for a loader while the positive button
+// callback runs, for callbacks that may take a while to return.
+func (tm *TextInputModal) ShowLoader(show bool) *TextInputModal {
+	tm.showLoader = show
+	return tm
+}
+
 func (tm *TextInputModal) ShowAccountInfoTip(show bool) *TextInputModal {
 	tm.showAccountWarnInfo = show
 	return tm
@@ -268,6 +268,10 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 			EnableConfirmPassword(false).
 			PasswordHint(values.String(values.StrSpendingPassword)).
 			SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
+				if utils.IsAccountNameTaken(pg.wallet, accountName) {
+					m.SetError(values.String(values.StrAccountNameTaken))
+					return false
+				}
 				_, err := pg.wallet.CreateNewAccount(accountName, password)
 				if err != nil {
 					m.SetError(err.Error())
@@ -0,0 +1,79 @@
+package components
+
+import (
+	"gioui.org/layout"
+
+	"github.com/crypto-power/cryptopower/app"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// EnableAccountCreation shows a "Create new account" row below the dropdown
+// that creates an account on the selected wallet without leaving the page.
+// The new account is selected once created. window is used to display the
+// account creation modal.
+func (d *AccountDropdown) EnableAccountCreation(window app.WindowNavigator) *AccountDropdown {
+	d.window = window
+	d.createAccountBtn = d.Theme.NewClickable(false)
+	return d
+}
+
+// canCreateAccount returns true if the create account row is displayed. It is
+// hidden for watch-only wallets since they can't derive new accounts.
+func (d *AccountDropdown) canCreateAccount() bool {
+	return d.createAccountBtn != nil && !d.multiSelect && d.selectedWallet != nil && d.selectedWallet.CanSign()
+}
+
+func (d *AccountDropdown) createAccountLayout(gtx C) D {
+	if !d.canCreateAccount() {
+		return D{}
+	}
+	return IconButton(d.Theme.Icons.ContentAdd, values.String(values.StrCreateNewAccount),
+		layout.Inset{Top: values.MarginPadding8}, d.Theme, d.createAccountBtn)(gtx)
+}
+
+// showCreateAccountModal asks for the name of the account and the spending
+// passphrase of the selected wallet and creates the account.
+func (d *AccountDropdown) showCreateAccountModal() {
+	wallet := d.selectedWallet
+	createAccountModal := modal.NewCreatePasswordModal(d.Load).
+		Title(values.String(values.StrCreateNewAccount)).
+		EnableName(true).
+		NameHint(values.String(values.StrAcctName)).
+		EnableConfirmPassword(false).
+		PasswordHint(values.String(values.StrSpendingPassword)).
+		SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
+			if pageutils.IsAccountNameTaken(wallet, accountName) {
+				m.SetError(values.String(values.StrAccountNameTaken))
+				return false
+			}
+			accountNumber, err := wallet.CreateNewAccount(accountName, password)
+			if err != nil {
+				m.SetError(err.Error())
+				return false
+			}
+			m.Dismiss()
+			d.accountCreated(wallet, accountNumber)
+			d.Toast.Notify(values.String(values.StrAcctCreated))
+			return true
+		})
+	d.window.ShowModal(createAccountModal)
+}
+
+// accountCreated lists the new account and selects it if it passes the
+// validator. The accounts are left as they are if another wallet was selected
+// while the account was being created.
+func (d *AccountDropdown) accountCreated(wallet sharedW.Asset, accountNumber int32) {
+	if d.selectedWallet == nil || d.selectedWallet.GetWalletID() != wallet.GetWalletID() {
+		return
+	}
+
+	account, err := wallet.GetAccount(accountNumber)
+	if err != nil || d.accountIsValid != nil && !d.accountIsValid(account) {
+		d.Setup(wallet, d.selectedAccount)
+		return
+	}
+	d.Setup(wallet, account)
+}
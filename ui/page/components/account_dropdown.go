@@ -2,14 +2,22 @@ package components
 
 import (
 	"fmt"
+	"io"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"gioui.org/font"
+	"gioui.org/io/clipboard"
 	"gioui.org/layout"
+	"gioui.org/widget"
 	"github.com/crypto-power/cryptopower/app"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -21,6 +29,48 @@ type AccountDropdown struct {
 	allAccounts            []*sharedW.Account
 	accountChangedCallback func(*sharedW.Account)
 	accountIsValid         func(*sharedW.Account) bool
+	hideZeroBalance        bool
+	balanceRefresher       balanceRefresher
+	// fiatBalance converts an account balance, in atoms, to a fiat string.
+	fiatBalance func(amount int64) string
+
+	// window displays the modals of the multi-select mode and the spendable
+	// balance explanation.
+	window app.WindowNavigator
+	// multiSelect fields are only used after EnableMultiSelect is called.
+	multiSelect       bool
+	showAccountsModal *cryptomaterial.Clickable
+	selectedAccounts  map[int32]bool
+	accountsCallback  func([]*sharedW.Account)
+	// spendableInfoButton explains the difference between the spendable
+	// and total balance, it is only set after EnableSpendableInfo is called.
+	spendableInfoButton *cryptomaterial.IconButton
+	// copyAddress shows an icon on the items of the accounts modal that
+	// copies the account's current receive address.
+	copyAddress bool
+	// ntfnWindow is set while the dropdown listens for notifications, the
+	// listener follows the selected wallet.
+	ntfnWindow app.WindowNavigator
+	// onEmpty is called when Setup leaves the dropdown without any account.
+	onEmpty func()
+	// scrollPositions holds the last scroll position of the accounts list of
+	// each wallet, keyed by wallet ID.
+	scrollPositions map[int]layout.Position
+	// refreshed holds the accounts fetched by the last balance refresh until
+	// they are applied by Layout, so the listed accounts are only modified on
+	// the UI goroutine.
+	refreshed   *refreshedAccounts
+	refreshedMu sync.Mutex
+	// startupApplied is set once the startup account was considered, it is
+	// only preferred by the first Setup so refreshes don't override the
+	// user's selection.
+	startupApplied bool
+	// balanceBreakdown expands the balance breakdown of the selected
+	// account, it is only set after ShowBalanceBreakdown is called.
+	balanceBreakdown *cryptomaterial.Collapsible
+	// createAccountBtn opens the account creation modal, it is only set
+	// after EnableAccountCreation is called.
+	createAccountBtn *cryptomaterial.Clickable
 }
 
 func NewAccountDropdown(l *load.Load) *AccountDropdown {
@@ -29,15 +79,67 @@ func NewAccountDropdown(l *load.Load) *AccountDropdown {
 		dropdown:        l.Theme.NewCommonDropDown([]cryptomaterial.DropDownItem{}, nil, cryptomaterial.MatchParent, values.AccountsDropdownGroup, false),
 		allAccounts:     make([]*sharedW.Account, 0),
 		selectedAccount: nil,
+		scrollPositions: make(map[int]layout.Position),
 	}
 	d.dropdown.BorderColor = &l.Theme.Color.Gray2
 	return d
 }
 
+// SetPeriodicRefresh enables a periodic balance refresh while the tx
+// notification listener is active. An interval of zero disables it.
+func (d *AccountDropdown) SetPeriodicRefresh(interval time.Duration) *AccountDropdown {
+	d.balanceRefresher.interval = interval
+	return d
+}
+
+// EnableSpendableInfo shows an info button beside the dropdown's title that
+// explains what the spendable balance is. window is used to display the
+// explanation.
+func (d *AccountDropdown) EnableSpendableInfo(window app.WindowNavigator) *AccountDropdown {
+	d.window = window
+	_, infoButton := SubpageHeaderButtons(d.Load)
+	infoButton.Inset = layout.Inset{}
+	infoButton.Size = values.MarginPadding16
+	d.spendableInfoButton = &infoButton
+	return d
+}
+
+// showSpendableInfo explains the spendable balance, including the amount of
+// the selected account locked in tickets for DCR wallets.
+func (d *AccountDropdown) showSpendableInfo() {
+	info := values.String(values.StrSpendableInfo)
+	if d.selectedWallet != nil && d.selectedWallet.GetAssetType() == libutils.DCRWalletAsset &&
+		d.selectedAccount != nil && d.selectedAccount.Balance != nil && d.selectedAccount.Balance.LockedByTickets != nil {
+		info += "\n\n" + values.StringF(values.StrLockedInTicketsInfo, d.formatBalance(d.selectedAccount.Balance.LockedByTickets))
+	}
+
+	infoModal := modal.NewCustomModal(d.Load).
+		Title(values.String(values.StrLabelSpendable)).
+		Body(info).
+		SetCancelable(true).
+		SetPositiveButtonText(values.String(values.StrGotIt))
+	d.window.ShowModal(infoModal)
+}
+
+// EnableAddressCopy shows an icon beside each account listed in the accounts
+// modal that copies the account's current receive address to the clipboard.
+func (d *AccountDropdown) EnableAddressCopy(enable bool) *AccountDropdown {
+	d.copyAddress = enable
+	return d
+}
+
+// OnEmpty sets a callback that is fired when no account of the selected wallet
+// passes the validator, so callers can dismiss the view and explain why.
+func (d *AccountDropdown) OnEmpty(fn func()) *AccountDropdown {
+	d.onEmpty = fn
+	return d
+}
+
 func (d *AccountDropdown) Setup(w sharedW.Asset, args ...*sharedW.Account) *AccountDropdown {
 	if w == nil {
 		return d
 	}
+	d.saveScrollPosition()
 	if len(args) > 0 {
 		d.selectedAccount = args[0]
 		if d.selectedAccount == nil {
@@ -45,18 +147,31 @@ func (d *AccountDropdown) Setup(w sharedW.Asset, args ...*sharedW.Account) *Acco
 		}
 	}
 
+	if d.ntfnWindow != nil && d.selectedWallet != nil && d.selectedWallet.GetWalletID() != w.GetWalletID() {
+		window := d.ntfnWindow
+		d.StopTxNtfnListener()
+		d.selectedWallet = w
+		d.ListenForTxNotifications(window)
+	}
 	d.selectedWallet = w
+	if len(args) < 1 && !d.startupApplied {
+		if account := d.startupAccount(); account != nil {
+			args = []*sharedW.Account{account}
+		}
+	}
+	d.startupApplied = true
 	items := []cryptomaterial.DropDownItem{}
 	d.allAccounts = make([]*sharedW.Account, 0)
 	accounts, err := d.selectedWallet.GetAccountsRaw()
 	if err != nil {
 		d.selectedAccount = nil
 		d.dropdown.SetItems(items)
+		d.notifyIfEmpty()
 		return d
 	}
 	isFirst := true
 	for _, account := range accounts.Accounts {
-		if d.accountIsValid == nil || d.accountIsValid(account) {
+		if d.isListed(account, args...) {
 			item := cryptomaterial.DropDownItem{
 				Text:      fmt.Sprint(account.Number),
 				Icon:      d.Theme.Icons.AccountIcon,
@@ -84,11 +199,221 @@ func (d *AccountDropdown) Setup(w sharedW.Asset, args ...*sharedW.Account) *Acco
 		}
 	}
 	d.dropdown.SetItems(items)
+	d.dropdown.SetScrollPosition(d.scrollPositions[w.GetWalletID()])
+	d.pruneSelectedAccounts()
+	d.notifyIfEmpty()
 	return d
 }
 
+// saveScrollPosition remembers the scroll position of the accounts list of
+// the selected wallet and forgets the positions of wallets that were deleted.
+func (d *AccountDropdown) saveScrollPosition() {
+	if d.selectedWallet == nil {
+		return
+	}
+	d.scrollPositions[d.selectedWallet.GetWalletID()] = d.dropdown.ScrollPosition()
+
+	existing := make(map[int]bool)
+	for _, wallet := range d.AssetsManager.AllWallets() {
+		existing[wallet.GetWalletID()] = true
+	}
+	for id := range d.scrollPositions {
+		if !existing[id] {
+			delete(d.scrollPositions, id)
+		}
+	}
+}
+
+func (d *AccountDropdown) notifyIfEmpty() {
+	if len(d.allAccounts) == 0 && d.onEmpty != nil {
+		d.onEmpty()
+	}
+}
+
+// startupAccount returns the configured startup account if the selected wallet
+// is the startup wallet and the account passes the validator. Nil is returned
+// otherwise.
+func (d *AccountDropdown) startupAccount() *sharedW.Account {
+	if !d.AssetsManager.IsStartupWalletSet() {
+		return nil
+	}
+	startupWallet, accountNumber := d.AssetsManager.StartupWallet()
+	if startupWallet == nil || accountNumber < 0 || startupWallet.GetWalletID() != d.selectedWallet.GetWalletID() {
+		return nil
+	}
+	account, err := d.selectedWallet.GetAccount(accountNumber)
+	if err != nil || d.accountIsValid != nil && !d.accountIsValid(account) {
+		return nil
+	}
+	return account
+}
+
 func (d *AccountDropdown) ResetAccount() {
 	d.selectedAccount = nil
+	if d.multiSelect {
+		d.selectedAccounts = make(map[int32]bool)
+	}
+}
+
+// EnableMultiSelect lets the user tick any number of accounts in a modal
+// instead of picking a single account from the dropdown. window is used to
+// display the modal.
+func (d *AccountDropdown) EnableMultiSelect(window app.WindowNavigator) *AccountDropdown {
+	d.multiSelect = true
+	d.window = window
+	d.showAccountsModal = d.Theme.NewClickable(true)
+	d.selectedAccounts = make(map[int32]bool)
+	return d
+}
+
+// SetAccountsCallback sets the function called with the selected accounts
+// when the user confirms the accounts picked in multi-select mode.
+func (d *AccountDropdown) SetAccountsCallback(callback func([]*sharedW.Account)) *AccountDropdown {
+	d.accountsCallback = callback
+	return d
+}
+
+// SelectedAccounts returns the accounts ticked in multi-select mode, in the
+// order the wallet lists them.
+func (d *AccountDropdown) SelectedAccounts() []*sharedW.Account {
+	if d == nil {
+		return nil
+	}
+	accounts := make([]*sharedW.Account, 0, len(d.selectedAccounts))
+	for _, account := range d.allAccounts {
+		if d.selectedAccounts[account.Number] {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts
+}
+
+// pruneSelectedAccounts drops the selected accounts that are no longer listed
+// after the accounts are reloaded.
+func (d *AccountDropdown) pruneSelectedAccounts() {
+	for number := range d.selectedAccounts {
+		if d.getAccountByNumber(number) == nil {
+			delete(d.selectedAccounts, number)
+		}
+	}
+}
+
+// ShowFiatBalance displays the fiat equivalent of the account balances below
+// the native amounts. rateSource is called for every balance displayed, nothing
+// is displayed for balances it returns an empty string for.
+func (d *AccountDropdown) ShowFiatBalance(rateSource func(amount int64) string) *AccountDropdown {
+	d.fiatBalance = rateSource
+	return d
+}
+
+// fiatBalanceLayout displays the fiat equivalent of amount in a small gray
+// label if a rate source is set.
+func (d *AccountDropdown) fiatBalanceLayout(gtx C, amount sharedW.AssetAmount) D {
+	if d.fiatBalance == nil || amount == nil {
+		return D{}
+	}
+	fiat := d.fiatBalance(amount.ToInt())
+	if fiat == "" {
+		return D{}
+	}
+	lbl := d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize12), fiat)
+	lbl.Color = d.Theme.Color.GrayText2
+	return lbl.Layout(gtx)
+}
+
+// HideZeroBalanceAccounts hides the accounts without any balance. The selected
+// account is always listed even if its balance is zero.
+func (d *AccountDropdown) HideZeroBalanceAccounts(hide bool) *AccountDropdown {
+	d.hideZeroBalance = hide
+	return d
+}
+
+// isListed returns true if account passes the validator and the zero balance
+// filter. selected holds the selected account, if any.
+func (d *AccountDropdown) isListed(account *sharedW.Account, selected ...*sharedW.Account) bool {
+	if d.isHiddenForZeroBalance(account, selected...) {
+		return false
+	}
+	return d.accountIsValid == nil || d.accountIsValid(account)
+}
+
+// refreshedAccounts are the accounts of a wallet fetched by a balance refresh.
+type refreshedAccounts struct {
+	walletID int
+	accounts []*sharedW.Account
+}
+
+// refreshAccounts fetches the accounts of the provided wallet, it is called
+// from the refresher goroutine. The accounts are applied by the next Layout.
+func (d *AccountDropdown) refreshAccounts(w sharedW.Asset) {
+	accounts, err := w.GetAccountsRaw()
+	if err != nil {
+		log.Errorf("refreshing the accounts failed: %v", err)
+		return
+	}
+
+	d.refreshedMu.Lock()
+	d.refreshed = &refreshedAccounts{walletID: w.GetWalletID(), accounts: accounts.Accounts}
+	d.refreshedMu.Unlock()
+}
+
+// applyRefreshedAccounts updates the balances and names of the listed
+// accounts in place so the dropdown keeps its state. The dropdown is only set
+// up again if the accounts to list have changed, the changed callback is then
+// only called if the selected account isn't listed anymore. Accounts fetched
+// for a wallet that isn't selected anymore are dropped.
+func (d *AccountDropdown) applyRefreshedAccounts() {
+	d.refreshedMu.Lock()
+	refreshed := d.refreshed
+	d.refreshed = nil
+	d.refreshedMu.Unlock()
+
+	if refreshed == nil || d.selectedWallet == nil || d.selectedWallet.GetWalletID() != refreshed.walletID {
+		return
+	}
+
+	listed := make([]*sharedW.Account, 0, len(d.allAccounts))
+	for _, account := range refreshed.accounts {
+		if d.isListed(account, d.selectedAccount) {
+			listed = append(listed, account)
+		}
+	}
+
+	isChanged := len(listed) != len(d.allAccounts)
+	for i := 0; !isChanged && i < len(listed); i++ {
+		isChanged = listed[i].Number != d.allAccounts[i].Number
+	}
+	if isChanged {
+		previous := d.selectedAccount
+		callback := d.accountChangedCallback
+		d.accountChangedCallback = nil
+		d.Setup(d.selectedWallet, d.selectedAccount)
+		d.accountChangedCallback = callback
+		if callback != nil && d.selectedAccount != nil && (previous == nil || previous.Number != d.selectedAccount.Number) {
+			callback(d.selectedAccount)
+		}
+		return
+	}
+
+	for i, account := range listed {
+		d.allAccounts[i].Name = account.Name
+		d.allAccounts[i].AccountName = account.AccountName
+		d.allAccounts[i].Balance = account.Balance
+	}
+}
+
+// isHiddenForZeroBalance returns true if account must not be listed because it
+// has no balance and isn't the selected account.
+func (d *AccountDropdown) isHiddenForZeroBalance(account *sharedW.Account, selected ...*sharedW.Account) bool {
+	if !d.hideZeroBalance || account.Balance == nil || account.Balance.Total == nil || account.Balance.Total.ToInt() > 0 {
+		return false
+	}
+	for _, acc := range selected {
+		if acc != nil && acc.AccountNumber == account.AccountNumber {
+			return false
+		}
+	}
+	return true
 }
 
 func (d *AccountDropdown) AccountValidator(accountIsValid func(*sharedW.Account) bool) *AccountDropdown {
@@ -108,7 +433,12 @@ func (d *AccountDropdown) getAccountItemLayout(account *sharedW.Account) layout.
 						return lbl.Layout(gtx)
 					}),
 					layout.Rigid(func(gtx C) D {
-						return d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize16), account.Balance.Total.String()).Layout(gtx)
+						return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
+							layout.Rigid(d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize16), d.formatBalance(account.Balance.Total)).Layout),
+							layout.Rigid(func(gtx C) D {
+								return d.fiatBalanceLayout(gtx, account.Balance.Total)
+							}),
+						)
 					}),
 				)
 			}),
@@ -123,7 +453,12 @@ func (d *AccountDropdown) getAccountItemLayout(account *sharedW.Account) layout.
 						if d.selectedWallet != nil && d.selectedWallet.IsWatchingOnlyWallet() {
 							account.Balance.Spendable = d.selectedWallet.ToAmount(0)
 						}
-						return d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize14), account.Balance.Spendable.String()).Layout(gtx)
+						return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
+							layout.Rigid(d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize14), d.formatBalance(account.Balance.Spendable)).Layout),
+							layout.Rigid(func(gtx C) D {
+								return d.fiatBalanceLayout(gtx, account.Balance.Spendable)
+							}),
+						)
 					}),
 				)
 			}),
@@ -131,6 +466,18 @@ func (d *AccountDropdown) getAccountItemLayout(account *sharedW.Account) layout.
 	}
 }
 
+// formatBalance formats amount using the unit of the selected wallet's asset
+// rather than trusting the concrete type of the account balance.
+func (d *AccountDropdown) formatBalance(amount sharedW.AssetAmount) string {
+	if amount == nil {
+		return ""
+	}
+	if d.selectedWallet == nil {
+		return amount.String()
+	}
+	return libutils.FormatAmount(d.selectedWallet.GetAssetType(), amount.ToInt())
+}
+
 func (d *AccountDropdown) getAccountByNumber(accountNumber int32) *sharedW.Account {
 	for _, account := range d.allAccounts {
 		if account.Number == accountNumber {
@@ -171,58 +518,227 @@ func (d *AccountDropdown) SetChangedCallback(callback func(*sharedW.Account)) *A
 }
 
 func (d *AccountDropdown) Handle(gtx C) {
+	if d.spendableInfoButton != nil && d.spendableInfoButton.Button.Clicked(gtx) {
+		d.showSpendableInfo()
+	}
+
+	if d.canCreateAccount() && d.createAccountBtn.Clicked(gtx) {
+		d.showCreateAccountModal()
+	}
+
+	if d.multiSelect {
+		if d.showAccountsModal.Clicked(gtx) {
+			d.showMultiSelectModal()
+		}
+		return
+	}
+
 	if d.dropdown.Changed(gtx) {
 		d.onChanged()
 	}
 }
 
+// showMultiSelectModal lists the accounts with a checkbox each. Toggling a
+// checkbox doesn't dismiss the modal, the selection is only applied when the
+// user confirms it.
+func (d *AccountDropdown) showMultiSelectModal() {
+	checkBoxes := make([]*widget.Bool, len(d.allAccounts))
+	copyButtons := make([]*cryptomaterial.Clickable, len(d.allAccounts))
+	for i, account := range d.allAccounts {
+		checkBoxes[i] = &widget.Bool{Value: d.selectedAccounts[account.Number]}
+		if d.copyAddress {
+			copyButtons[i] = d.Theme.NewClickable(false)
+		}
+	}
+
+	accountsModal := modal.NewCustomModal(d.Load).
+		Title(values.String(values.StrSelectAccounts)).
+		UseCustomWidget(func(gtx C) D {
+			items := make([]layout.FlexChild, 0, len(d.allAccounts))
+			for i, account := range d.allAccounts {
+				account, checkBox, copyButton := account, checkBoxes[i], copyButtons[i]
+				if copyButton != nil && copyButton.Clicked(gtx) {
+					d.copyAccountAddress(gtx, account)
+				}
+				items = append(items, layout.Rigid(func(gtx C) D {
+					return d.modalListItemLayout(gtx, account, checkBox, copyButton)
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, items...)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrConfirm)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			checked := make([]bool, len(checkBoxes))
+			for i, checkBox := range checkBoxes {
+				checked[i] = checkBox.Value
+			}
+			d.confirmSelection(checked)
+			return true
+		})
+	d.window.ShowModal(accountsModal)
+}
+
+// confirmSelection selects the listed accounts whose checkbox is checked and
+// passes them to the accounts callback. checked holds a value per listed
+// account.
+func (d *AccountDropdown) confirmSelection(checked []bool) {
+	d.selectedAccounts = make(map[int32]bool)
+	for i, account := range d.allAccounts {
+		if i < len(checked) && checked[i] {
+			d.selectedAccounts[account.Number] = true
+		}
+	}
+	if d.accountsCallback != nil {
+		d.accountsCallback(d.SelectedAccounts())
+	}
+}
+
+// copyAccountAddress copies the current receive address of the account to the
+// clipboard.
+func (d *AccountDropdown) copyAccountAddress(gtx C, account *sharedW.Account) {
+	address, err := d.selectedWallet.CurrentAddress(account.Number)
+	if err != nil {
+		d.Toast.NotifyError(err.Error())
+		return
+	}
+	gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(address))})
+	d.Toast.Notify(values.String(values.StrCopied))
+}
+
+// modalListItemLayout lays out an account of the accounts modal. The copy
+// address icon is only shown if copyButton isn't nil.
+func (d *AccountDropdown) modalListItemLayout(gtx C, account *sharedW.Account, checkBox *widget.Bool, copyButton *cryptomaterial.Clickable) D {
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(d.Theme.CheckBox(checkBox, account.AccountName).Layout),
+			layout.Flexed(1, func(gtx C) D {
+				return layout.E.Layout(gtx, func(gtx C) D {
+					textSize := values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
+					return layout.Flex{Axis: layout.Vertical, Alignment: layout.End}.Layout(gtx,
+						layout.Rigid(d.Theme.Label(textSize, d.formatBalance(account.Balance.Total)).Layout),
+						layout.Rigid(func(gtx C) D {
+							return d.fiatBalanceLayout(gtx, account.Balance.Total)
+						}),
+					)
+				})
+			}),
+			layout.Rigid(func(gtx C) D {
+				if copyButton == nil {
+					return D{}
+				}
+				return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+					return copyButton.Layout(gtx, d.Theme.NewIcon(d.Theme.Icons.CopyIcon).Layout20dp)
+				})
+			}),
+		)
+	})
+}
+
+func (d *AccountDropdown) multiSelectLayout(gtx C) D {
+	border := widget.Border{
+		Color:        d.Theme.Color.Gray2,
+		CornerRadius: values.MarginPadding8,
+		Width:        values.MarginPadding2,
+	}
+
+	return border.Layout(gtx, func(gtx C) D {
+		return d.showAccountsModal.Layout(gtx, func(gtx C) D {
+			return layout.UniformInset(values.MarginPadding12).Layout(gtx, func(gtx C) D {
+				textSize := values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
+				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						count := len(d.selectedAccounts)
+						if count == 0 {
+							txt := d.Theme.Label(textSize, values.String(values.StrSelectAccounts))
+							txt.Color = d.Theme.Color.GrayText3
+							return txt.Layout(gtx)
+						}
+						return d.Theme.Label(textSize, values.StringF(values.StrAccountsSelected, count)).Layout(gtx)
+					}),
+					layout.Flexed(1, func(gtx C) D {
+						return layout.E.Layout(gtx, func(gtx C) D {
+							ic := cryptomaterial.NewIcon(d.Theme.Icons.DropDownIcon)
+							ic.Color = d.Theme.Color.Gray1
+							return ic.Layout(gtx, values.MarginPadding20)
+						})
+					}),
+				)
+			})
+		})
+	})
+}
+
 func (d *AccountDropdown) Layout(gtx C, title string) D {
+	d.applyRefreshedAccounts()
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
 			lbl := d.Theme.H6(title)
 			lbl.TextSize = values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
 			lbl.Font.Weight = font.SemiBold
-			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(lbl.Layout),
+					layout.Rigid(func(gtx C) D {
+						if d.spendableInfoButton == nil {
+							return D{}
+						}
+						return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, d.spendableInfoButton.Layout)
+					}),
+				)
+			})
 		}),
 		layout.Rigid(func(gtx C) D {
+			if d.multiSelect {
+				return d.multiSelectLayout(gtx)
+			}
 			return d.dropdown.Layout(gtx)
 		}),
+		layout.Rigid(d.balanceBreakdownLayout),
+		layout.Rigid(d.createAccountLayout),
 	)
 }
 
 // ListenForTxNotifications listens for transaction and block updates and
-// updates the selector modal, if the modal is open at the time of the update.
+// refreshes the balances of the listed accounts. The listener moves to the
+// wallet set up next, if another wallet is set up.
 // The tx update listener MUST be unregistered using ws.StopTxNtfnListener()
 // when the page using this WalletAndAccountSelector widget is exited.
 func (d *AccountDropdown) ListenForTxNotifications(window app.WindowNavigator) {
+	if d.selectedWallet == nil {
+		return
+	}
+	// The refresh only updates the balances, the selection hasn't changed
+	// so the changed callback isn't called.
+	w := d.selectedWallet
+	refreshBalance := func() {
+		d.refreshAccounts(w)
+		window.Reload()
+	}
 	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
 		OnTransaction: func(_ int, _ *sharedW.Transaction) {
 			// refresh wallets/Accounts list when new transaction is received
-			if d.accountChangedCallback != nil && d.selectedAccount != nil {
-				d.accountChangedCallback(d.selectedAccount)
-				window.Reload()
-			}
+			d.balanceRefresher.notify(refreshBalance)
 		},
 		OnBlockAttached: func(_ int, _ int32) {
 			// refresh wallet and account balance on every new block
 			// only if sync is completed.
-			if d.accountChangedCallback != nil && d.selectedAccount != nil {
-				d.accountChangedCallback(d.selectedAccount)
-				window.Reload()
-			}
+			d.balanceRefresher.notify(refreshBalance)
 		},
 	}
-	if d.selectedWallet == nil {
-		return
-	}
-	err := d.selectedWallet.AddTxAndBlockNotificationListener(txAndBlockNotificationListener, WalletAndAccountSelectorID)
+	err := d.selectedWallet.AddTxAndBlockNotificationListener(txAndBlockNotificationListener, accountDropdownID)
 	if err != nil {
-		log.Errorf("WalletAndAccountSelector.ListenForTxNotifications error: %v", err)
+		log.Errorf("AccountDropdown.ListenForTxNotifications error: %v", err)
+		return
 	}
+	d.ntfnWindow = window
+	d.balanceRefresher.start(refreshBalance)
 }
 
 func (d *AccountDropdown) StopTxNtfnListener() {
+	d.balanceRefresher.stop()
+	d.ntfnWindow = nil
 	if d.selectedWallet != nil {
-		d.selectedWallet.RemoveTxAndBlockNotificationListener(WalletAndAccountSelectorID)
+		d.selectedWallet.RemoveTxAndBlockNotificationListener(accountDropdownID)
 	}
 }
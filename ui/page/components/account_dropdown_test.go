@@ -0,0 +1,147 @@
+package components
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/assets"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+)
+
+// newTestAccountDropdown returns a dropdown listing accounts with the
+// provided numbers.
+func newTestAccountDropdown(numbers ...int32) *AccountDropdown {
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	d := NewAccountDropdown(&load.Load{AppInfo: new(load.AppInfo), Theme: th})
+	for _, number := range numbers {
+		d.allAccounts = append(d.allAccounts, &sharedW.Account{Number: number})
+	}
+	return d
+}
+
+func accountNumbers(accounts []*sharedW.Account) []int32 {
+	numbers := make([]int32, 0, len(accounts))
+	for _, account := range accounts {
+		numbers = append(numbers, account.Number)
+	}
+	return numbers
+}
+
+func TestAccountDropdownMultiSelect(t *testing.T) {
+	d := newTestAccountDropdown(0, 1, 2)
+	var confirmed []*sharedW.Account
+	calls := 0
+	d.EnableMultiSelect(nil).SetAccountsCallback(func(accounts []*sharedW.Account) {
+		calls++
+		confirmed = accounts
+	})
+
+	if len(d.SelectedAccounts()) != 0 {
+		t.Fatal("expected no account to be selected initially")
+	}
+
+	d.confirmSelection([]bool{true, false, true})
+	if calls != 1 {
+		t.Fatalf("expected the callback to be fired once, got %d", calls)
+	}
+	if got := accountNumbers(confirmed); !reflect.DeepEqual(got, []int32{0, 2}) {
+		t.Fatalf("expected accounts [0 2] to be confirmed, got %v", got)
+	}
+	if got := accountNumbers(d.SelectedAccounts()); !reflect.DeepEqual(got, []int32{0, 2}) {
+		t.Fatalf("expected accounts [0 2] to be selected, got %v", got)
+	}
+
+	// Confirming again replaces the selection.
+	d.confirmSelection([]bool{false, true, false})
+	if got := accountNumbers(d.SelectedAccounts()); !reflect.DeepEqual(got, []int32{1}) {
+		t.Fatalf("expected account 1 to be selected, got %v", got)
+	}
+
+	// Accounts that are no longer listed are dropped from the selection.
+	d.confirmSelection([]bool{true, true, false})
+	d.allAccounts = d.allAccounts[1:]
+	d.pruneSelectedAccounts()
+	if got := accountNumbers(d.SelectedAccounts()); !reflect.DeepEqual(got, []int32{1}) {
+		t.Fatalf("expected account 1 to remain selected, got %v", got)
+	}
+
+	d.ResetAccount()
+	if len(d.SelectedAccounts()) != 0 {
+		t.Fatal("expected the selection to be cleared")
+	}
+}
+
+// accountsWallet is a stub wallet whose accounts can be refreshed.
+type accountsWallet struct {
+	stubWallet
+	accounts []*sharedW.Account
+}
+
+func (w *accountsWallet) GetAccountsRaw() (*sharedW.Accounts, error) {
+	return &sharedW.Accounts{Accounts: w.accounts}, nil
+}
+
+func TestAccountDropdownRefresh(t *testing.T) {
+	d := newTestAccountDropdown(0, 1)
+	w := &accountsWallet{stubWallet: stubWallet{id: 1}}
+	d.selectedWallet = w
+	listed := d.allAccounts[0]
+
+	balance := &sharedW.Balance{}
+	w.accounts = []*sharedW.Account{{Number: 0, Balance: balance}, {Number: 1}}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		d.refreshAccounts(w)
+	}()
+	<-done
+
+	// The refreshed balances are only applied on the UI goroutine.
+	if listed.Balance != nil {
+		t.Fatal("expected the balance to be applied by Layout")
+	}
+	d.applyRefreshedAccounts()
+	if d.allAccounts[0] != listed || listed.Balance != balance {
+		t.Fatal("expected the balance to be updated in place")
+	}
+
+	// Accounts fetched for a wallet that isn't selected anymore are dropped.
+	other := &accountsWallet{stubWallet: stubWallet{id: 2}, accounts: []*sharedW.Account{{Number: 0}, {Number: 1}}}
+	d.refreshAccounts(other)
+	d.applyRefreshedAccounts()
+	if listed.Balance != balance {
+		t.Fatal("expected the accounts of another wallet to be dropped")
+	}
+}
+
+func TestAccountDropdownHideZeroBalance(t *testing.T) {
+	d := newTestAccountDropdown()
+	account := func(number int32, total int64) *sharedW.Account {
+		return &sharedW.Account{Number: number, Balance: &sharedW.Balance{Total: dcr.Amount(total)}}
+	}
+	empty, funded := account(0, 0), account(1, 1000)
+
+	if !d.isListed(empty) || !d.isListed(funded) {
+		t.Fatal("expected every account to be listed by default")
+	}
+
+	d.HideZeroBalanceAccounts(true)
+	if d.isListed(empty) {
+		t.Fatal("expected the account without balance to be hidden")
+	}
+	if !d.isListed(funded) {
+		t.Fatal("expected the account with a balance to be listed")
+	}
+	if !d.isListed(empty, account(0, 0)) {
+		t.Fatal("expected the selected account to be listed even without balance")
+	}
+
+	// The validator still applies to accounts with a balance.
+	d.AccountValidator(func(account *sharedW.Account) bool { return account.Number != 1 })
+	if d.isListed(funded) {
+		t.Fatal("expected the account rejected by the validator to be hidden")
+	}
+}
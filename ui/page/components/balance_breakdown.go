@@ -0,0 +1,125 @@
+package components
+
+import (
+	"gioui.org/layout"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// BalanceBreakdown is the balance of an account split by what the funds can
+// currently be used for. Amounts are in atoms.
+type BalanceBreakdown struct {
+	Total     int64
+	Spendable int64
+	// Immature holds both the immature coinbase and stake generation rewards.
+	Immature int64
+	// Locked is the value of the outputs locked from being spent.
+	Locked int64
+
+	// Staking is true if the asset locks funds in tickets, the fields below
+	// are only set if it is.
+	Staking         bool
+	LockedByTickets int64
+	VotingAuthority int64
+}
+
+// AccountBalanceBreakdown returns the balance breakdown of the account of wal.
+// Nothing can be spent from a watch-only wallet so its spendable balance is
+// zero.
+func AccountBalanceBreakdown(wal sharedW.Asset, account *sharedW.Account) BalanceBreakdown {
+	if account == nil {
+		return BalanceBreakdown{}
+	}
+	return balanceBreakdown(account.Balance, wal.IsWatchingOnlyWallet())
+}
+
+func balanceBreakdown(balance *sharedW.Balance, watchOnly bool) BalanceBreakdown {
+	if balance == nil {
+		return BalanceBreakdown{}
+	}
+
+	breakdown := BalanceBreakdown{
+		Total:     atoms(balance.Total),
+		Spendable: atoms(balance.Spendable),
+		Immature:  atoms(balance.ImmatureReward) + atoms(balance.ImmatureStakeGeneration),
+		Locked:    atoms(balance.Locked),
+		Staking:   balance.LockedByTickets != nil,
+	}
+	if watchOnly {
+		breakdown.Spendable = 0
+	}
+	if breakdown.Staking {
+		breakdown.LockedByTickets = atoms(balance.LockedByTickets)
+		breakdown.VotingAuthority = atoms(balance.VotingAuthority)
+	}
+	return breakdown
+}
+
+// atoms returns the amount in atoms, zero if the amount isn't set.
+func atoms(amount sharedW.AssetAmount) int64 {
+	if amount == nil {
+		return 0
+	}
+	return amount.ToInt()
+}
+
+// ShowBalanceBreakdown displays an expander below the dropdown that breaks
+// down the balance of the selected account into its spendable, immature and
+// locked amounts.
+func (d *AccountDropdown) ShowBalanceBreakdown() *AccountDropdown {
+	d.balanceBreakdown = d.Theme.Collapsible()
+	return d
+}
+
+func (d *AccountDropdown) balanceBreakdownLayout(gtx C) D {
+	if d.balanceBreakdown == nil || d.multiSelect || d.selectedWallet == nil || d.selectedAccount == nil {
+		return D{}
+	}
+
+	breakdown := AccountBalanceBreakdown(d.selectedWallet, d.selectedAccount)
+	rows := []struct {
+		label  string
+		amount int64
+	}{
+		{values.String(values.StrLabelSpendable), breakdown.Spendable},
+		{values.String(values.StrImmature), breakdown.Immature},
+		{values.String(values.StrLocked), breakdown.Locked},
+	}
+	if breakdown.Staking {
+		rows = append(rows, []struct {
+			label  string
+			amount int64
+		}{
+			{values.String(values.StrLockedByTickets), breakdown.LockedByTickets},
+			{values.String(values.StrVotingAuthority), breakdown.VotingAuthority},
+		}...)
+	}
+
+	textSize := values.TextSizeTransform(d.IsMobileView(), values.TextSize14)
+	return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return d.balanceBreakdown.Layout(gtx, func(gtx C) D {
+			lbl := d.Theme.Label(textSize, values.String(values.StrBalanceBreakdown))
+			lbl.Color = d.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}, func(gtx C) D {
+			children := make([]layout.FlexChild, 0, len(rows))
+			for _, row := range rows {
+				row := row
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+						return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
+							layout.Rigid(func(gtx C) D {
+								lbl := d.Theme.Label(textSize, row.label)
+								lbl.Color = d.Theme.Color.GrayText2
+								return lbl.Layout(gtx)
+							}),
+							layout.Rigid(d.Theme.Label(textSize, d.formatBalance(d.selectedWallet.ToAmount(row.amount))).Layout),
+						)
+					})
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+)
+
+func TestBalanceBreakdown(t *testing.T) {
+	tests := []struct {
+		name      string
+		balance   *sharedW.Balance
+		watchOnly bool
+		want      BalanceBreakdown
+	}{{
+		name: "no balance",
+		want: BalanceBreakdown{},
+	}, {
+		name: "dcr staking balances",
+		balance: &sharedW.Balance{
+			Total:                   dcr.Amount(1000),
+			Spendable:               dcr.Amount(400),
+			ImmatureReward:          dcr.Amount(50),
+			ImmatureStakeGeneration: dcr.Amount(150),
+			Locked:                  dcr.Amount(100),
+			LockedByTickets:         dcr.Amount(300),
+			VotingAuthority:         dcr.Amount(300),
+		},
+		want: BalanceBreakdown{
+			Total:           1000,
+			Spendable:       400,
+			Immature:        200,
+			Locked:          100,
+			Staking:         true,
+			LockedByTickets: 300,
+			VotingAuthority: 300,
+		},
+	}, {
+		name: "btc balance without staking fields",
+		balance: &sharedW.Balance{
+			Total:          btc.Amount(1000),
+			Spendable:      btc.Amount(900),
+			ImmatureReward: btc.Amount(100),
+			Locked:         btc.Amount(0),
+		},
+		want: BalanceBreakdown{
+			Total:     1000,
+			Spendable: 900,
+			Immature:  100,
+		},
+	}, {
+		name: "watch-only wallet can't spend",
+		balance: &sharedW.Balance{
+			Total:     btc.Amount(1000),
+			Spendable: btc.Amount(1000),
+		},
+		watchOnly: true,
+		want: BalanceBreakdown{
+			Total: 1000,
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := balanceBreakdown(test.balance, test.watchOnly); got != test.want {
+				t.Fatalf("expected %+v, got %+v", test.want, got)
+			}
+		})
+	}
+}
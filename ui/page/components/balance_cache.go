@@ -0,0 +1,73 @@
+package components
+
+import (
+	"sync"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+)
+
+// walletBalanceCacheTTL bounds how long a cached balance is used. It ensures
+// balances are eventually refreshed for wallets whose notifications aren't
+// listened to.
+const walletBalanceCacheTTL = 30 * time.Second
+
+type cachedBalance struct {
+	total, spendable int64
+	computedAt       time.Time
+}
+
+// walletBalanceCache stores the last computed total and spendable balance of
+// wallets so that layouts don't query the accounts of every wallet on every
+// frame. Entries are invalidated by tx and block notifications.
+type walletBalanceCache struct {
+	mu       sync.Mutex
+	balances map[int]cachedBalance
+	now      func() time.Time
+}
+
+func newWalletBalanceCache() *walletBalanceCache {
+	return &walletBalanceCache{
+		balances: make(map[int]cachedBalance),
+		now:      time.Now,
+	}
+}
+
+// balance returns the cached balance of the wallet, calling compute to
+// refresh it if it isn't cached or has expired.
+func (c *walletBalanceCache) balance(walletID int, compute func() (total, spendable int64)) (total, spendable int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cached, ok := c.balances[walletID]; ok && c.now().Sub(cached.computedAt) < walletBalanceCacheTTL {
+		return cached.total, cached.spendable
+	}
+
+	total, spendable = compute()
+	c.balances[walletID] = cachedBalance{
+		total:      total,
+		spendable:  spendable,
+		computedAt: c.now(),
+	}
+	return total, spendable
+}
+
+// invalidate drops the cached balance of the wallet.
+func (c *walletBalanceCache) invalidate(walletID int) {
+	c.mu.Lock()
+	delete(c.balances, walletID)
+	c.mu.Unlock()
+}
+
+// listener returns a notification listener that invalidates the balance of
+// the notifying wallet on new transactions and blocks.
+func (c *walletBalanceCache) listener() *sharedW.TxAndBlockNotificationListener {
+	return &sharedW.TxAndBlockNotificationListener{
+		OnTransaction: func(walletID int, _ *sharedW.Transaction) {
+			c.invalidate(walletID)
+		},
+		OnBlockAttached: func(walletID int, _ int32) {
+			c.invalidate(walletID)
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package components
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWalletBalanceCache(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cache := newWalletBalanceCache()
+	cache.now = func() time.Time { return now }
+
+	var calls int
+	var total int64 = 100
+	compute := func() (int64, int64) {
+		calls++
+		return total, total / 2
+	}
+	listener := cache.listener()
+
+	tests := []struct {
+		name      string
+		before    func()
+		wantTotal int64
+		wantCalls int
+	}{{
+		name:      "first read computes",
+		wantTotal: 100,
+		wantCalls: 1,
+	}, {
+		name:      "later read is cached",
+		before:    func() { total = 150 },
+		wantTotal: 100,
+		wantCalls: 1,
+	}, {
+		name:      "block on another wallet keeps the cache",
+		before:    func() { listener.OnBlockAttached(2, 10) },
+		wantTotal: 100,
+		wantCalls: 1,
+	}, {
+		name:      "block invalidates",
+		before:    func() { listener.OnBlockAttached(1, 10) },
+		wantTotal: 150,
+		wantCalls: 2,
+	}, {
+		name: "transaction invalidates",
+		before: func() {
+			total = 175
+			listener.OnTransaction(1, nil)
+		},
+		wantTotal: 175,
+		wantCalls: 3,
+	}, {
+		name: "expired entry is recomputed",
+		before: func() {
+			total = 200
+			now = now.Add(walletBalanceCacheTTL)
+		},
+		wantTotal: 200,
+		wantCalls: 4,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.before != nil {
+				test.before()
+			}
+			gotTotal, gotSpendable := cache.balance(1, compute)
+			if gotTotal != test.wantTotal || gotSpendable != test.wantTotal/2 {
+				t.Fatalf("expected balance %d/%d, got %d/%d", test.wantTotal, test.wantTotal/2, gotTotal, gotSpendable)
+			}
+			if calls != test.wantCalls {
+				t.Fatalf("expected %d balance computations, got %d", test.wantCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package components
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// notificationDebounce is how long notification driven refreshes are delayed
+// so that a burst of notifications, e.g. while catching up on sync, results in
+// a single refresh.
+const notificationDebounce = 500 * time.Millisecond
+
+// balanceRefresher re-runs a balance refresh on a fixed interval, in addition
+// to the notification driven refreshes of the selectors. It is disabled
+// unless an interval greater than zero is set.
+type balanceRefresher struct {
+	interval   time.Duration
+	refreshing atomic.Bool
+	cancel     context.CancelFunc
+
+	pendingMtx sync.Mutex
+	pending    *time.Timer
+}
+
+// refresh runs fn unless another refresh is already in progress, so ticker
+// and notification refreshes never stack on top of each other. Returns false
+// if fn wasn't run.
+func (r *balanceRefresher) refresh(fn func()) bool {
+	if !r.refreshing.CompareAndSwap(false, true) {
+		return false
+	}
+	defer r.refreshing.Store(false)
+	fn()
+	return true
+}
+
+// notify schedules a refresh notificationDebounce from now. Notifications
+// received before the scheduled refresh runs are coalesced into it, and the
+// refresh is scheduled again if it collides with one in progress so the
+// latest state is always refreshed.
+func (r *balanceRefresher) notify(fn func()) {
+	r.pendingMtx.Lock()
+	defer r.pendingMtx.Unlock()
+	if r.pending != nil {
+		return
+	}
+	r.pending = time.AfterFunc(notificationDebounce, func() {
+		r.pendingMtx.Lock()
+		r.pending = nil
+		r.pendingMtx.Unlock()
+		if !r.refresh(fn) {
+			r.notify(fn)
+		}
+	})
+}
+
+// start launches the ticker goroutine. Any previously started goroutine is
+// stopped first. It is a no-op if periodic refresh is disabled.
+func (r *balanceRefresher) start(fn func()) {
+	r.stop()
+	if r.interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	go func(interval time.Duration) {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refresh(fn)
+			}
+		}
+	}(r.interval)
+}
+
+// stop cancels the ticker goroutine if one is running and drops any pending
+// notification refresh.
+func (r *balanceRefresher) stop() {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	r.pendingMtx.Lock()
+	if r.pending != nil {
+		r.pending.Stop()
+		r.pending = nil
+	}
+	r.pendingMtx.Unlock()
+}
@@ -0,0 +1,49 @@
+package components
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBalanceRefresherNotifyCoalesces(t *testing.T) {
+	var r balanceRefresher
+	var calls atomic.Int32
+	refreshed := make(chan struct{}, 10)
+	fn := func() {
+		calls.Add(1)
+		refreshed <- struct{}{}
+	}
+
+	for i := 0; i < 100; i++ {
+		r.notify(fn)
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(5 * notificationDebounce):
+		t.Fatal("burst of notifications was not refreshed")
+	}
+
+	// A notification after the burst is refreshed on its own.
+	r.notify(fn)
+	select {
+	case <-refreshed:
+	case <-time.After(5 * notificationDebounce):
+		t.Fatal("notification after the burst was not refreshed")
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 refreshes, got %d", got)
+	}
+}
+
+func TestBalanceRefresherStopDropsPending(t *testing.T) {
+	var r balanceRefresher
+	var calls atomic.Int32
+	r.notify(func() { calls.Add(1) })
+	r.stop()
+
+	time.Sleep(2 * notificationDebounce)
+	if got := calls.Load(); got != 0 {
+		t.Fatalf("expected the pending refresh to be dropped, got %d refreshes", got)
+	}
+}
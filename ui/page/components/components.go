@@ -364,6 +364,17 @@ func LayoutTransactionRow(gtx C, l *load.Load, wal sharedW.Asset, tx *sharedW.Tr
 						}),
 					)
 				}),
+				layout.Rigid(func(gtx C) D {
+					// Callers apply the wallet labels with
+					// sharedW.ApplyTxLabels when fetching the txs.
+					if tx.Label == "" {
+						return D{}
+					}
+					lbl := l.Theme.Label(values.TextSize12, tx.Label)
+					lbl.Color = grayText
+					lbl.MaxLines = 1
+					return lbl.Layout(gtx)
+				}),
 			)
 		}),
 		layout.Flexed(1, func(gtx C) D {
@@ -569,7 +580,7 @@ func GoToURL(url string) {
 		err = fmt.Errorf("unsupported platform")
 	}
 	if err != nil {
-		fmt.Println(err.Error())
+		log.Errorf("error opening url: %v", err)
 	}
 }
 
@@ -635,6 +646,31 @@ func TxPageDropDownFields(wType libutils.AssetType, tabIndex int) (mapInfo map[s
 	return
 }
 
+// TxLabel returns the label of the transaction. Labels set from the
+// transaction details, cleared ones included, override the description set
+// when sending.
+func TxLabel(wal sharedW.Asset, tx *sharedW.Transaction) string {
+	if label, ok := wal.TxLabelsMap()[tx.Hash]; ok {
+		return label
+	}
+	return tx.Label
+}
+
+// TxTagDisplayName returns the localized name of a transaction tag. An empty
+// tag is displayed as "None".
+func TxTagDisplayName(tag string) string {
+	switch tag {
+	case sharedW.TxTagIncome:
+		return values.String(values.StrIncome)
+	case sharedW.TxTagExpense:
+		return values.String(values.StrExpense)
+	case sharedW.TxTagTransfer:
+		return values.String(values.StrTransfer)
+	default:
+		return values.String(values.StrNone)
+	}
+}
+
 // CoinImageBySymbol returns image widget for supported asset coins.
 func CoinImageBySymbol(l *load.Load, assetType libutils.AssetType, isWatchOnly bool) *cryptomaterial.Image {
 	switch assetType.ToStringLower() {
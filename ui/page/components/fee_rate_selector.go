@@ -1,6 +1,7 @@
 package components
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -12,20 +13,41 @@ import (
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
 type walletTypeCallbackFunc func() libutils.AssetType
 
+// feeRateTargets lists the confirmation targets, in blocks, fee rates are
+// estimated for when the selected wallet supports estimates.
+var feeRateTargets = []struct {
+	label      string
+	confTarget int32
+}{
+	{values.StrEconomy, 144},
+	{values.StrNormal, 6},
+	{values.StrHighPriority, 1},
+}
+
 // FeeRateSelector represent a tx fee selector UI component.
 type FeeRateSelector struct {
 	*load.Load
 
 	feeRateText string
+	// minFeeRateText holds the lowest fee rate txs from the selected wallet
+	// are allowed to use.
+	minFeeRateText string
 	// SaveRate is a material button to trigger save tx fee rate.
 	SaveRate cryptomaterial.Button
 
 	fetchedRatesDropDown *cryptomaterial.DropDown
+	// estimatedRates holds the fee rate of each fetchedRatesDropDown item
+	// when the rates were estimated for feeRateTargets.
+	estimatedRates []int64
+	// estimateErrText is shown instead of the tx details if the fee rates
+	// couldn't be estimated.
+	estimateErrText string
 
 	feeRateSwitch *cryptomaterial.SegmentedControl
 
@@ -59,6 +81,7 @@ func NewFeeRateSelector(l *load.Load, callback walletTypeCallbackFunc) *FeeRateS
 	}
 
 	fs.feeRateText = " - "
+	fs.minFeeRateText = " - "
 	fs.EstSignedSize = "-"
 	fs.TxFee = " - "
 	fs.TxFeeUSD = " - "
@@ -165,16 +188,21 @@ func (fs *FeeRateSelector) Layout(gtx C) D {
 					col := fs.Theme.Color.GrayText2
 					txSize := values.StringF(values.StrTxSize, fmt.Sprintf(": %s", fs.EstSignedSize))
 					priority := values.StringF(values.StrPriority, fmt.Sprintf(": %s", fs.priority))
-					txt := fmt.Sprintf("%s, %s", priority, txSize)
+					minFeeRate := values.StringF(values.StrMinFeeRate, fmt.Sprintf(": %s", fs.minFeeRateText))
+					txt := fmt.Sprintf("%s, %s, %s", priority, txSize, minFeeRate)
 					if fs.showSizeAndCost {
 						feeText := fs.TxFee
 						if fs.USDExchangeSet {
 							feeText = values.StringF(values.StrCost, fmt.Sprintf("%s (%s)", fs.TxFee, fs.TxFeeUSD))
 						}
-						txt = fmt.Sprintf("%s, %s, %s", priority, txSize, feeText)
+						txt = fmt.Sprintf("%s, %s, %s, %s", priority, txSize, minFeeRate, feeText)
 					}
 
 					// update label text and color if any of the conditions are met below
+					if fs.estimateErrText != "" && fs.feeRateSwitch.SelectedSegment() == values.String(values.StrFetched) {
+						txt = fs.estimateErrText
+						col = fs.Theme.Color.Danger
+					}
 					if !fs.isFeerateAPIApproved() {
 						txt = values.StringF(values.StrNotAllowed, values.String(values.StrFeeRates))
 						col = fs.Theme.Color.Danger
@@ -202,26 +230,39 @@ func (fs *FeeRateSelector) UpdatedFeeRate(selectedWallet sharedW.Asset) {
 		fs.fetchingRate = false
 	}()
 
-	feeRates, err := load.GetAPIFeeRate(selectedWallet)
-	if err != nil {
-		return
-	}
-
-	blocksStr := func(b int32) string {
-		val := strconv.Itoa(int(b)) + " block"
-		if b == 1 {
-			return val
+	fs.UpdateMinFeeRate(selectedWallet)
+
+	var items []cryptomaterial.DropDownItem
+	var estimatedRates []int64
+	if selectedWallet.GetAssetType() == libutils.BTCWalletAsset {
+		fs.estimateErrText = ""
+		for _, target := range feeRateTargets {
+			rate, err := load.FeeRate(selectedWallet, target.confTarget)
+			if err != nil {
+				if errors.Is(err, libutils.ErrChainClientNotStarted) {
+					fs.estimateErrText = values.String(values.StrConnectToEstimateFees)
+				}
+				return
+			}
+			estimatedRates = append(estimatedRates, rate)
+			items = append(items, cryptomaterial.DropDownItem{
+				Text: values.String(target.label) + " - " + fs.addRatesUnits(rate) + " (" + blocksStr(target.confTarget) + ")",
+			})
+		}
+	} else {
+		feeRates, err := load.GetAPIFeeRate(selectedWallet)
+		if err != nil {
+			return
 		}
-		return val + "s"
-	}
 
-	items := []cryptomaterial.DropDownItem{}
-	for index := range feeRates {
-		items = append(items, cryptomaterial.DropDownItem{
-			Text: fs.addRatesUnits(feeRates[index].Feerate.ToInt()) + " - " + blocksStr(feeRates[index].ConfirmedBlocks),
-		})
+		for index := range feeRates {
+			items = append(items, cryptomaterial.DropDownItem{
+				Text: fs.addRatesUnits(feeRates[index].Feerate.ToInt()) + " - " + blocksStr(feeRates[index].ConfirmedBlocks),
+			})
+		}
 	}
 
+	fs.estimatedRates = estimatedRates
 	fs.fetchedRatesDropDown = fs.Theme.DropDown(items, nil, values.WalletsDropdownGroup, false)
 	fs.fetchedRatesDropDown.FontWeight = font.SemiBold
 	fs.fetchedRatesDropDown.Hoverable = false
@@ -232,17 +273,51 @@ func (fs *FeeRateSelector) UpdatedFeeRate(selectedWallet sharedW.Asset) {
 	fs.fetchedRatesDropDown.SetMaxTextLeng(30)
 }
 
+// HandleEstimatedRate applies the fee rate of the estimate selected from the
+// fetched rates dropdown to the provided wallet. It returns true if the fee
+// rate in use changed.
+func (fs *FeeRateSelector) HandleEstimatedRate(gtx C, selectedWallet sharedW.Asset) bool {
+	if !fs.fetchedRatesDropDown.Changed(gtx) {
+		return false
+	}
+
+	index := fs.fetchedRatesDropDown.SelectedIndex()
+	if index < 0 || index >= len(fs.estimatedRates) {
+		return false
+	}
+
+	rateInt, err := load.SetAPIFeeRate(selectedWallet, strconv.FormatInt(fs.estimatedRates[index], 10))
+	if err != nil {
+		fs.Toast.NotifyError(err.Error())
+		return false
+	}
+	fs.feeRateText = fs.addRatesUnits(rateInt)
+	return true
+}
+
 // OnEditRateCliked is called when the edit feerate button is clicked.
 func (fs *FeeRateSelector) OnEditRateClicked(selectedWallet sharedW.Asset) {
 	rateStr := fs.ratesEditor.Editor.Text()
 	rateInt, err := load.SetAPIFeeRate(selectedWallet, rateStr)
 	if err != nil {
 		fs.feeRateText = " - "
+		fs.Toast.NotifyError(err.Error())
 	} else {
 		fs.feeRateText = fs.addRatesUnits(rateInt)
 	}
 }
 
+// UpdateMinFeeRate refreshes the minimum fee rate displayed for the provided
+// wallet.
+func (fs *FeeRateSelector) UpdateMinFeeRate(selectedWallet sharedW.Asset) {
+	minRate, err := load.GetMinFeeRate(selectedWallet)
+	if err != nil {
+		fs.minFeeRateText = " - "
+		return
+	}
+	fs.minFeeRateText = fs.addRatesUnits(minRate)
+}
+
 func (fs *FeeRateSelector) addRatesUnits(rates int64) string {
 	return fs.Load.Printer.Sprintf("%d %s", rates, fs.ratesUnit())
 }
@@ -260,7 +335,28 @@ func (fs *FeeRateSelector) ratesUnit() string {
 func (fs *FeeRateSelector) SetFeerate(rateInt int64) {
 	if rateInt == 0 {
 		fs.feeRateText = " - "
+		fs.priority = values.String(values.StrUnknown)
 		return
 	}
 	fs.feeRateText = fs.addRatesUnits(rateInt)
 }
+
+// UpdateConfirmationEstimate sets the displayed priority to the estimated
+// confirmation target of a tx from the provided wallet paying rateInt. The
+// priority is shown as unknown if no estimate is available.
+func (fs *FeeRateSelector) UpdateConfirmationEstimate(selectedWallet sharedW.Asset, rateInt int64) {
+	blocks, eta, known := load.EstimateConfirmations(selectedWallet, rateInt)
+	if !known {
+		fs.priority = values.String(values.StrUnknown)
+		return
+	}
+	fs.priority = fmt.Sprintf("%s (~%s)", blocksStr(blocks), utils.TimeFormat(int(eta.Seconds()), true))
+}
+
+func blocksStr(b int32) string {
+	val := strconv.Itoa(int(b)) + " block"
+	if b == 1 {
+		return val
+	}
+	return val + "s"
+}
@@ -177,7 +177,7 @@ func (sp *SubPage) EventHandler(gtx C, window app.WindowNavigator) {
 	}
 
 	if sp.BackButton.Button.Clicked(gtx) {
-		sp.Back()
+		modal.ConfirmPendingOperations(sp.Load, window, sp.Back)
 	}
 
 	if sp.ExtraItem != nil && sp.ExtraItem.Clicked(gtx) && sp.HandleExtra != nil {
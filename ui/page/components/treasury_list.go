@@ -10,6 +10,7 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -18,6 +19,13 @@ type TreasuryItem struct {
 	OptionsRadioGroup *widget.Enum
 	VoteChoices       [3]string
 	SetChoiceButton   cryptomaterial.Button
+
+	// History lists the policies previously set from the wallet, most
+	// recent first. It's displayed when ShowHistory is set by clicking
+	// HistoryToggle.
+	History       []dcr.TreasuryPolicyChange
+	HistoryToggle *cryptomaterial.Clickable
+	ShowHistory   bool
 }
 
 func (t *TreasuryItem) SetVoteChoices(voteChoices [3]string) {
@@ -25,6 +33,18 @@ func (t *TreasuryItem) SetVoteChoices(voteChoices [3]string) {
 }
 
 func TreasuryItemWidget(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return layoutPolicyChoice(gtx, l, treasuryItem)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return layoutPolicyHistory(gtx, l, treasuryItem)
+		}),
+	)
+}
+
+func layoutPolicyChoice(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
 	gtx.Constraints.Min.X = gtx.Constraints.Max.X
 	axis := layout.Horizontal
 	if l.IsMobileView() {
@@ -52,6 +72,63 @@ func TreasuryItemWidget(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
 	)
 }
 
+// layoutPolicyHistory displays the history toggle and, when expanded, the
+// policies previously set from the wallet.
+func layoutPolicyHistory(gtx C, l *load.Load, treasuryItem *TreasuryItem) D {
+	if treasuryItem.HistoryToggle == nil {
+		return D{}
+	}
+
+	textSize := l.ConvertTextSize(values.TextSize14)
+	return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				return treasuryItem.HistoryToggle.Layout(gtx, func(gtx C) D {
+					icon := cryptomaterial.NewIcon(l.Theme.Icons.ChevronDown)
+					if treasuryItem.ShowHistory {
+						icon = cryptomaterial.NewIcon(l.Theme.Icons.ChevronUp)
+					}
+					icon.Color = l.Theme.Color.Primary
+					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+						layout.Rigid(func(gtx C) D {
+							lbl := l.Theme.Label(textSize, values.String(values.StrVoteHistory))
+							lbl.Color = l.Theme.Color.Primary
+							return lbl.Layout(gtx)
+						}),
+						layout.Rigid(func(gtx C) D {
+							return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+								return icon.Layout(gtx, values.MarginPadding16)
+							})
+						}),
+					)
+				})
+			}),
+			layout.Rigid(func(gtx C) D {
+				if !treasuryItem.ShowHistory {
+					return D{}
+				}
+
+				if len(treasuryItem.History) == 0 {
+					lbl := l.Theme.Label(textSize, values.String(values.StrNoPriorVote))
+					lbl.Color = l.Theme.Color.GrayText3
+					return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+				}
+
+				rows := make([]layout.FlexChild, 0, len(treasuryItem.History))
+				for _, change := range treasuryItem.History {
+					text := values.StringF(values.StrPolicyHistoryEntry, change.Policy, change.Height, utils.FormatDateOrTime(change.Timestamp))
+					lbl := l.Theme.Label(textSize, text)
+					lbl.Color = l.Theme.Color.GrayText2
+					rows = append(rows, layout.Rigid(func(gtx C) D {
+						return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+					}))
+				}
+				return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+			}),
+		)
+	})
+}
+
 func layoutItems(l *load.Load, treasuryItem *TreasuryItem) []layout.FlexChild {
 	voteChoices := [...]string{
 		strings.ToLower(values.String(values.StrYes)),
@@ -97,12 +174,26 @@ func LayoutNoPoliciesFound(gtx C, l *load.Load, syncing bool) D {
 	})
 }
 
-func LoadPolicies(l *load.Load, selectedDCRWallet *dcr.Asset, pikey string) []*TreasuryItem {
-	policies, err := selectedDCRWallet.TreasuryPolicies(pikey, "")
-	if err != nil {
-		return nil
+// FilterTreasuryItems returns the items whose Pi key or policy contains the
+// query, ignoring case. All items are returned if the query is empty.
+func FilterTreasuryItems(items []*TreasuryItem, query string) []*TreasuryItem {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return items
 	}
 
+	filtered := make([]*TreasuryItem, 0, len(items))
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(item.Policy.PiKey), query) ||
+			strings.Contains(strings.ToLower(item.Policy.Policy), query) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// NewTreasuryItems returns the list items that display the policies.
+func NewTreasuryItems(l *load.Load, policies []*dcr.TreasuryKeyPolicy) []*TreasuryItem {
 	treasuryItems := make([]*TreasuryItem, len(policies))
 	for i := 0; i < len(policies); i++ {
 		button := l.Theme.Button(values.String(values.StrSetChoice))
@@ -111,6 +202,7 @@ func LoadPolicies(l *load.Load, selectedDCRWallet *dcr.Asset, pikey string) []*T
 			Policy:            *policies[i],
 			OptionsRadioGroup: new(widget.Enum),
 			SetChoiceButton:   button,
+			HistoryToggle:     l.Theme.NewClickable(false),
 		}
 
 		treasuryItems[i].OptionsRadioGroup.Value = treasuryItems[i].Policy.Policy
@@ -0,0 +1,33 @@
+package components
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+)
+
+func TestFilterTreasuryItems(t *testing.T) {
+	items := []*TreasuryItem{
+		{Policy: dcr.TreasuryKeyPolicy{PiKey: "03f6e7041f1cf51ee10e0a01cd2b0385ce3cd9debaabb2296f7e9dee9329da946c", Policy: "yes"}},
+		{Policy: dcr.TreasuryKeyPolicy{PiKey: "0319a37405cb4d1691971847d7719cfce70857c0f6e97d7c9174a3998cf0ab86dd", Policy: "abstain"}},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{name: "empty query", query: "  ", want: 2},
+		{name: "pi key prefix", query: "03F6E7", want: 1},
+		{name: "policy", query: "Abstain", want: 1},
+		{name: "no match", query: "no", want: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := FilterTreasuryItems(items, test.query); len(got) != test.want {
+				t.Fatalf("expected %d items, got %d", test.want, len(got))
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package components
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"gioui.org/font"
@@ -104,7 +105,7 @@ func (v *VSPSelector) Layout(window app.WindowNavigator, gtx C) D {
 									if v.selectedVSP == nil {
 										return D{}
 									}
-									txt := v.Theme.Label(textSize16, fmt.Sprintf("%v%%", v.selectedVSP.FeePercentage))
+									txt := v.Theme.Label(textSize16, vspFeeText(v.selectedVSP))
 									return txt.Layout(gtx)
 								}),
 								layout.Rigid(func(gtx C) D {
@@ -137,13 +138,16 @@ type vspSelectorModal struct {
 
 	selectedVSP *dcr.VSP
 	vspList     *cryptomaterial.ClickableList
+	// vsps are the known VSPs in the order they were last displayed.
+	vsps         []*dcr.VSP
+	sortByStatus bool
+	sortToggle   *cryptomaterial.Clickable
 
 	vspSelectedCallback func(*dcr.VSP)
 
 	dcrImpl *dcr.Asset
 
 	materialLoader material.LoaderStyle
-	isLoadingVSP   bool
 }
 
 func newVSPSelectorModal(l *load.Load, dcrWallet *dcr.Asset) *vspSelectorModal {
@@ -154,6 +158,7 @@ func newVSPSelectorModal(l *load.Load, dcrWallet *dcr.Asset) *vspSelectorModal {
 		inputVSP:       l.Theme.Editor(new(widget.Editor), values.String(values.StrAddVSP)),
 		addVSP:         l.Theme.Button(values.String(values.StrSave)),
 		vspList:        l.Theme.NewClickableList(layout.Vertical),
+		sortToggle:     l.Theme.NewClickable(false),
 		dcrImpl:        dcrWallet,
 		materialLoader: material.Loader(l.Theme.Base),
 	}
@@ -167,13 +172,24 @@ func newVSPSelectorModal(l *load.Load, dcrWallet *dcr.Asset) *vspSelectorModal {
 func (v *vspSelectorModal) OnResume() {
 	if len(v.dcrImpl.KnownVSPs()) == 0 {
 		go func() {
-			v.isLoadingVSP = true // This is used to set the UI to loading VSP state.
-			v.dcrImpl.ReloadVSPList(context.TODO())
-			// set isLoadingVSP to false, this indicates to the UI that we are done
-			// loading vsp(s)
-			v.isLoadingVSP = false
+			v.dcrImpl.LoadVSPList(context.TODO())
 			v.ParentWindow().Reload()
+			v.checkVSPStatuses()
 		}()
+		return
+	}
+
+	v.checkVSPStatuses()
+}
+
+// checkVSPStatuses checks whether each known VSP is online. Recently checked
+// VSPs aren't queried again.
+func (v *vspSelectorModal) checkVSPStatuses() {
+	for _, vsp := range v.dcrImpl.KnownVSPs() {
+		go func(host string) {
+			v.dcrImpl.CheckVSPStatus(host)
+			v.ParentWindow().Reload()
+		}(vsp.Host)
 	}
 }
 
@@ -199,8 +215,12 @@ func (v *vspSelectorModal) Handle(gtx C) {
 		v.Dismiss()
 	}
 
-	if clicked, selectedItem := v.vspList.ItemClicked(); clicked {
-		v.selectedVSP = v.dcrImpl.KnownVSPs()[selectedItem]
+	if v.sortToggle.Clicked(gtx) {
+		v.sortByStatus = !v.sortByStatus
+	}
+
+	if clicked, selectedItem := v.vspList.ItemClicked(); clicked && selectedItem < len(v.vsps) {
+		v.selectedVSP = v.vsps[selectedItem]
 		v.vspSelectedCallback(v.selectedVSP)
 		v.Dismiss()
 	}
@@ -220,11 +240,13 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 	textSize20 := values.TextSizeTransform(v.IsMobileView(), values.TextSize20)
 	textSize14 := values.TextSizeTransform(v.IsMobileView(), values.TextSize14)
 	textSize16 := values.TextSizeTransform(v.IsMobileView(), values.TextSize16)
+	// The list may be reloaded by other pages too, not only by this modal.
+	isLoading := v.dcrImpl.IsLoadingVSPList()
 	return v.Modal.Layout(gtx, []layout.Widget{
 		func(gtx C) D {
 			title := v.Theme.Label(textSize20, v.dialogTitle)
 			// Override title when VSP is loading.
-			if v.isLoadingVSP {
+			if isLoading {
 				title = v.Theme.Label(textSize20, values.String(values.StrLoadingVSP))
 			}
 			title.Font.Weight = font.SemiBold
@@ -234,25 +256,38 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(func(gtx C) D {
 					// Return 0 dimension if VSP is loading.
-					if v.isLoadingVSP {
+					if isLoading {
+						return D{}
+					}
+
+					return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+						return layout.E.Layout(gtx, v.sortToggleLayout)
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					// Return 0 dimension if VSP is loading.
+					if isLoading {
 						return D{}
 					}
 
 					txt := v.Theme.Label(textSize14, values.String(values.StrAddress))
 					txt.Color = v.Theme.Color.GrayText2
+					txtStatus := v.Theme.Label(textSize14, values.String(values.StrStatus))
+					txtStatus.Color = v.Theme.Color.GrayText2
 					txtFee := v.Theme.Label(textSize14, values.String(values.StrFee))
 					txtFee.Color = v.Theme.Color.GrayText2
-					return EndToEndRow(gtx, txt.Layout, txtFee.Layout)
+					return vspRowLayout(gtx, txt.Layout, txtStatus.Layout, txtFee.Layout)
 				}),
 				layout.Rigid(func(gtx C) D {
 					// if VSP(s) are being loaded, show loading UI.
-					if v.isLoadingVSP {
+					if isLoading {
 						return layout.UniformInset(values.MarginPadding140).Layout(gtx, v.materialLoader.Layout)
 					}
 
 					// if no vsp loaded, display a no vsp text
-					vsps := v.dcrImpl.KnownVSPs()
-					if len(vsps) == 0 && !v.isLoadingVSP {
+					vsps := sortVSPs(v.dcrImpl.KnownVSPs(), v.sortByStatus, v.dcrImpl.VSPStatus)
+					v.vsps = vsps
+					if len(vsps) == 0 && !isLoading {
 						noVsp := v.Theme.Label(textSize14, values.String(values.StrNoVSPLoaded))
 						noVsp.Color = v.Theme.Color.GrayText2
 						return layout.Inset{Top: values.MarginPadding5}.Layout(gtx, noVsp.Layout)
@@ -264,9 +299,9 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
 							layout.Flexed(0.8, func(gtx C) D {
 								return layout.Inset{Top: values.MarginPadding12, Bottom: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
-									txt := v.Theme.Label(textSize14, fmt.Sprintf("%v%%", vsps[i].FeePercentage))
+									txt := v.Theme.Label(textSize14, vspFeeText(vsps[i]))
 									txt.Color = v.Theme.Color.GrayText1
-									return EndToEndRow(gtx, v.Theme.Label(textSize16, vsps[i].Host).Layout, txt.Layout)
+									return vspRowLayout(gtx, v.Theme.Label(textSize16, vsps[i].Host).Layout, v.statusLayout(vsps[i].Host), txt.Layout)
 								})
 							}),
 							layout.Rigid(func(gtx C) D {
@@ -283,7 +318,7 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 		},
 		func(gtx C) D {
 			// Return 0 dimension if VSP is loading.
-			if v.isLoadingVSP {
+			if isLoading {
 				return D{}
 			}
 
@@ -295,6 +330,100 @@ func (v *vspSelectorModal) Layout(gtx C) D {
 	})
 }
 
+// sortToggleLayout displays the current sort order of the VSPs. Clicking it
+// switches between sorting by fee and by online status.
+func (v *vspSelectorModal) sortToggleLayout(gtx C) D {
+	orderKey := values.StrFee
+	if v.sortByStatus {
+		orderKey = values.StrStatus
+	}
+	return v.sortToggle.Layout(gtx, func(gtx C) D {
+		lbl := v.Theme.Label(values.TextSizeTransform(v.IsMobileView(), values.TextSize14), values.StringF(values.StrSortedBy, values.String(orderKey)))
+		lbl.Color = v.Theme.Color.Primary
+		return lbl.Layout(gtx)
+	})
+}
+
+// statusLayout displays whether the VSP was online when last checked and,
+// for offline VSPs, when it was last seen online.
+func (v *vspSelectorModal) statusLayout(host string) layout.Widget {
+	textSize := values.TextSizeTransform(v.IsMobileView(), values.TextSize14)
+	status, checked := v.dcrImpl.VSPStatus(host)
+	lbl := v.Theme.Label(textSize, values.String(values.StrCheckingVSP))
+	lbl.Color = v.Theme.Color.GrayText3
+	switch {
+	case !checked:
+	case status.Online:
+		lbl.Text = values.String(values.StrVSPOnline)
+		lbl.Color = v.Theme.Color.Success
+	case status.LastSeen > 0:
+		lbl.Text = values.StringF(values.StrVSPLastSeen, utils.TimeAgo(status.LastSeen))
+		lbl.Color = v.Theme.Color.Danger
+	default:
+		lbl.Text = values.String(values.StrVSPOffline)
+		lbl.Color = v.Theme.Color.Danger
+	}
+	return lbl.Layout
+}
+
+// vspRowLayout lays out the host, status and fee columns of a VSP row.
+func vspRowLayout(gtx C, host, status, fee layout.Widget) D {
+	return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+		layout.Flexed(0.55, host),
+		layout.Flexed(0.3, status),
+		layout.Flexed(0.15, func(gtx C) D {
+			return layout.E.Layout(gtx, fee)
+		}),
+	)
+}
+
+// vspFeeText returns the fee percentage of the VSP for display, a dash is
+// displayed for VSPs whose info wasn't fetched.
+func vspFeeText(vsp *dcr.VSP) string {
+	if vsp.VspInfoResponse == nil {
+		return "—"
+	}
+	return fmt.Sprintf("%v%%", vsp.FeePercentage)
+}
+
+// sortVSPs returns a copy of vsps sorted by fee, cheapest first, VSPs whose
+// info wasn't fetched are listed last. If byStatus is true, online VSPs are
+// listed first followed by the ones not checked yet and those that are
+// offline, each group sorted by fee.
+func sortVSPs(vsps []*dcr.VSP, byStatus bool, vspStatus func(host string) (dcr.VSPStatus, bool)) []*dcr.VSP {
+	sorted := make([]*dcr.VSP, len(vsps))
+	copy(sorted, vsps)
+
+	statusRank := func(host string) int {
+		status, checked := vspStatus(host)
+		switch {
+		case !checked:
+			return 1
+		case status.Online:
+			return 0
+		default:
+			return 2
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if byStatus {
+			if ri, rj := statusRank(sorted[i].Host), statusRank(sorted[j].Host); ri != rj {
+				return ri < rj
+			}
+		}
+		// VSPs without info have no known fee.
+		if ki, kj := sorted[i].VspInfoResponse != nil, sorted[j].VspInfoResponse != nil; ki != kj {
+			return ki
+		}
+		if ki := sorted[i].VspInfoResponse != nil; ki && sorted[i].FeePercentage != sorted[j].FeePercentage {
+			return sorted[i].FeePercentage < sorted[j].FeePercentage
+		}
+		return sorted[i].Host < sorted[j].Host
+	})
+	return sorted
+}
+
 func (v *vspSelectorModal) editorsNotEmpty(editors ...*widget.Editor) bool {
 	for _, e := range editors {
 		if strings.TrimSpace(e.Text()) == "" {
@@ -0,0 +1,72 @@
+package components
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	vspd "github.com/decred/vspd/types/v2"
+)
+
+func TestSortVSPs(t *testing.T) {
+	newVSP := func(host string, fee float64) *dcr.VSP {
+		return &dcr.VSP{Host: host, VspInfoResponse: &vspd.VspInfoResponse{FeePercentage: fee}}
+	}
+	vsps := []*dcr.VSP{
+		newVSP("offline", 0.5),
+		newVSP("unchecked", 1),
+		newVSP("online-expensive", 2),
+		{Host: "no-info"},
+		newVSP("online-cheap", 1),
+	}
+	statuses := map[string]dcr.VSPStatus{
+		"offline":          {Online: false},
+		"online-expensive": {Online: true},
+		"online-cheap":     {Online: true},
+		"no-info":          {Online: true},
+	}
+	vspStatus := func(host string) (dcr.VSPStatus, bool) {
+		status, ok := statuses[host]
+		return status, ok
+	}
+
+	tests := []struct {
+		name     string
+		byStatus bool
+		want     []string
+	}{{
+		name: "by fee",
+		want: []string{"offline", "online-cheap", "unchecked", "online-expensive", "no-info"},
+	}, {
+		name:     "by status",
+		byStatus: true,
+		want:     []string{"online-cheap", "online-expensive", "no-info", "unchecked", "offline"},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sorted := sortVSPs(vsps, test.byStatus, vspStatus)
+			got := make([]string, 0, len(sorted))
+			for _, vsp := range sorted {
+				got = append(got, vsp.Host)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+
+	if vsps[0].Host != "offline" {
+		t.Fatal("sortVSPs reordered the input slice")
+	}
+}
+
+func TestVSPFeeText(t *testing.T) {
+	vsp := &dcr.VSP{Host: "vsp", VspInfoResponse: &vspd.VspInfoResponse{FeePercentage: 0.5}}
+	if got := vspFeeText(vsp); got != "0.5%" {
+		t.Fatalf("expected 0.5%%, got %s", got)
+	}
+	if got := vspFeeText(&dcr.VSP{Host: "no-info"}); got != "—" {
+		t.Fatalf("expected a dash for a VSP without info, got %s", got)
+	}
+}
@@ -0,0 +1,141 @@
+package components
+
+import (
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// WalletCheckList lists wallets with a checkbox each, for operations that
+// apply to several wallets at once. The list has "select all" and "clear"
+// actions.
+type WalletCheckList struct {
+	*load.Load
+
+	wallets      []sharedW.Asset
+	checkBoxes   []*widget.Bool
+	balanceCache *walletBalanceCache
+
+	selectAll      *cryptomaterial.Clickable
+	clearSelection *cryptomaterial.Clickable
+}
+
+// NewWalletCheckList returns a list of the wallets with none selected.
+func NewWalletCheckList(l *load.Load, wallets []sharedW.Asset) *WalletCheckList {
+	wc := &WalletCheckList{
+		Load:           l,
+		wallets:        wallets,
+		checkBoxes:     make([]*widget.Bool, len(wallets)),
+		balanceCache:   newWalletBalanceCache(),
+		selectAll:      l.Theme.NewClickable(false),
+		clearSelection: l.Theme.NewClickable(false),
+	}
+	for i := range wc.checkBoxes {
+		wc.checkBoxes[i] = new(widget.Bool)
+	}
+	return wc
+}
+
+// SelectedWallets returns the checked wallets in the order they're listed.
+func (wc *WalletCheckList) SelectedWallets() []sharedW.Asset {
+	selected := make([]sharedW.Asset, 0, len(wc.wallets))
+	for i, wallet := range wc.wallets {
+		if wc.checkBoxes[i].Value {
+			selected = append(selected, wallet)
+		}
+	}
+	return selected
+}
+
+// SetSelected checks the wallets with the provided IDs and unchecks the rest.
+func (wc *WalletCheckList) SetSelected(walletIDs ...int) {
+	ids := make(map[int]bool, len(walletIDs))
+	for _, id := range walletIDs {
+		ids[id] = true
+	}
+	for i, wallet := range wc.wallets {
+		wc.checkBoxes[i].Value = ids[wallet.GetWalletID()]
+	}
+}
+
+// SelectAll checks every wallet if selected is true, otherwise it unchecks
+// every wallet.
+func (wc *WalletCheckList) SelectAll(selected bool) {
+	for _, checkBox := range wc.checkBoxes {
+		checkBox.Value = selected
+	}
+}
+
+func (wc *WalletCheckList) handle(gtx C) {
+	if wc.selectAll.Clicked(gtx) {
+		wc.SelectAll(true)
+	}
+	if wc.clearSelection.Clicked(gtx) {
+		wc.SelectAll(false)
+	}
+}
+
+func (wc *WalletCheckList) Layout(gtx C) D {
+	wc.handle(gtx)
+
+	items := make([]layout.FlexChild, 0, len(wc.wallets)+1)
+	items = append(items, layout.Rigid(wc.actionsLayout))
+	for i, wallet := range wc.wallets {
+		wallet, checkBox := wallet, wc.checkBoxes[i]
+		items = append(items, layout.Rigid(func(gtx C) D {
+			return wc.walletItemLayout(gtx, wallet, checkBox)
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, items...)
+}
+
+func (wc *WalletCheckList) actionsLayout(gtx C) D {
+	textSize := values.TextSizeTransform(wc.IsMobileView(), values.TextSize14)
+	action := func(clickable *cryptomaterial.Clickable, text string) layout.Widget {
+		return func(gtx C) D {
+			return clickable.Layout(gtx, func(gtx C) D {
+				lbl := wc.Theme.Label(textSize, text)
+				lbl.Color = wc.Theme.Color.Primary
+				return lbl.Layout(gtx)
+			})
+		}
+	}
+
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.E.Layout(gtx, func(gtx C) D {
+			return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+				layout.Rigid(action(wc.selectAll, values.String(values.StrSelectAll))),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Left: values.MarginPadding16}.Layout(gtx, action(wc.clearSelection, values.String(values.StrClear)))
+				}),
+			)
+		})
+	})
+}
+
+func (wc *WalletCheckList) walletItemLayout(gtx C, wallet sharedW.Asset, checkBox *widget.Bool) D {
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Right: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+					return wc.Theme.AssetIcon(wallet.GetAssetType()).LayoutSize(gtx, values.MarginPadding20)
+				})
+			}),
+			layout.Rigid(wc.Theme.CheckBox(checkBox, wallet.GetWalletName()).Layout),
+			layout.Flexed(1, func(gtx C) D {
+				return layout.E.Layout(gtx, func(gtx C) D {
+					total, _ := wc.balanceCache.balance(wallet.GetWalletID(), func() (int64, int64) {
+						return computeWalletBalance(wallet)
+					})
+					textSize := values.TextSizeTransform(wc.IsMobileView(), values.TextSize16)
+					return wc.Theme.Label(textSize, libutils.FormatAmount(wallet.GetAssetType(), total)).Layout(gtx)
+				})
+			}),
+		)
+	})
+}
@@ -0,0 +1,49 @@
+package components
+
+import (
+	"reflect"
+	"testing"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/assets"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+)
+
+func TestWalletCheckList(t *testing.T) {
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	wallets := []sharedW.Asset{&stubWallet{id: 1}, &stubWallet{id: 2}, &stubWallet{id: 3}}
+	wc := NewWalletCheckList(&load.Load{AppInfo: new(load.AppInfo), Theme: th}, wallets)
+
+	walletIDs := func() []int {
+		ids := make([]int, 0, len(wallets))
+		for _, wallet := range wc.SelectedWallets() {
+			ids = append(ids, wallet.GetWalletID())
+		}
+		return ids
+	}
+
+	if ids := walletIDs(); len(ids) != 0 {
+		t.Fatalf("expected no wallet to be selected initially, got %v", ids)
+	}
+
+	wc.SetSelected(3, 1)
+	if ids := walletIDs(); !reflect.DeepEqual(ids, []int{1, 3}) {
+		t.Fatalf("expected wallets [1 3] in the listed order, got %v", ids)
+	}
+
+	// Wallets not provided are unchecked.
+	wc.SetSelected(2)
+	if ids := walletIDs(); !reflect.DeepEqual(ids, []int{2}) {
+		t.Fatalf("expected wallet 2 to be selected, got %v", ids)
+	}
+
+	wc.SelectAll(true)
+	if ids := walletIDs(); !reflect.DeepEqual(ids, []int{1, 2, 3}) {
+		t.Fatalf("expected every wallet to be selected, got %v", ids)
+	}
+	wc.SelectAll(false)
+	if ids := walletIDs(); len(ids) != 0 {
+		t.Fatalf("expected the selection to be cleared, got %v", ids)
+	}
+}
@@ -2,7 +2,10 @@ package components
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"gioui.org/font"
 	"gioui.org/layout"
@@ -11,10 +14,30 @@ import (
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
+	pageutils "github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
-const WalletAndAccountSelectorID = "WalletAndAccountSelector"
+const (
+	WalletAndAccountSelectorID = "WalletAndAccountSelector"
+	// accountDropdownID identifies the listener of the account dropdown so it
+	// doesn't replace the wallet dropdown's listener on the same wallet.
+	accountDropdownID = "AccountDropdown"
+	// walletBalanceCacheID identifies the listeners that invalidate the cached
+	// balances of the wallets that aren't selected.
+	walletBalanceCacheID = "WalletDropdownBalanceCache"
+)
+
+// walletSortOrder is the order the wallets are listed in.
+type walletSortOrder int
+
+const (
+	sortWalletsByName walletSortOrder = iota
+	sortWalletsByBalance
+	// sortWalletsByCustomOrder keeps the order chosen by the user, it is only
+	// available if the user ordered the wallets.
+	sortWalletsByCustomOrder
+)
 
 type WalletDropdown struct {
 	*load.Load
@@ -23,57 +46,210 @@ type WalletDropdown struct {
 	allWallets            []sharedW.Asset
 	walletChangedCallback func(sharedW.Asset)
 	walletIsValid         func(sharedW.Asset) bool
+	walletDisabled        func(sharedW.Asset) (bool, string)
 	isWatchOnlyEnabled    bool
+	includeHidden         bool
 	assetTypes            []utils.AssetType
+	balanceRefresher      balanceRefresher
+	balanceCache          *walletBalanceCache
+	sortOrder             walletSortOrder
+	sortToggle            *cryptomaterial.Clickable
+	// startupApplied is set once the startup wallet was considered, it is
+	// only preferred by the first Setup so refreshes don't override the
+	// user's selection.
+	startupApplied bool
+	// showTotal displays the combined balance of the listed wallets, it is
+	// only set after ShowTotalBalance is called.
+	showTotal bool
+	// fiatRate returns the USD rate of an asset, zero if it is unavailable.
+	fiatRate func(utils.AssetType) float64
+}
+
+// assetTotal is the combined balance of the listed wallets of an asset.
+type assetTotal struct {
+	assetType utils.AssetType
+	total     int64
+	spendable int64
 }
 
 func NewWalletDropdown(l *load.Load, assetType ...utils.AssetType) *WalletDropdown {
 	wd := &WalletDropdown{
-		Load:     l,
-		dropdown: l.Theme.NewCommonDropDown([]cryptomaterial.DropDownItem{}, nil, cryptomaterial.MatchParent, values.WalletsDropdownGroup, false),
+		Load:         l,
+		dropdown:     l.Theme.NewCommonDropDown([]cryptomaterial.DropDownItem{}, nil, cryptomaterial.MatchParent, values.WalletsDropdownGroup, false),
+		balanceCache: newWalletBalanceCache(),
+		sortToggle:   l.Theme.NewClickable(false),
 	}
 	wd.dropdown.BorderColor = &l.Theme.Color.Gray2
 	wd.assetTypes = assetType
+	if wd.hasCustomOrder() {
+		wd.sortOrder = sortWalletsByCustomOrder
+	}
 	return wd
 }
 
 func (d *WalletDropdown) Setup(args ...sharedW.Asset) *WalletDropdown {
-	if len(args) > 0 {
-		d.selectedWallet = args[0]
-		if d.selectedWallet == nil {
-			args = []sharedW.Asset{}
-		}
+	// The startup wallet is only preferred by the first Setup and if no
+	// wallet is requested.
+	var requested, startupWallet sharedW.Asset
+	if len(args) > 0 && args[0] != nil {
+		requested = args[0]
+	} else if !d.startupApplied && d.AssetsManager.IsStartupWalletSet() {
+		startupWallet, _ = d.AssetsManager.StartupWallet()
 	}
+	d.startupApplied = true
 	d.allWallets = make([]sharedW.Asset, 0)
 	wallets := d.AssetsManager.AssetWallets(d.assetTypes...)
+	d.sortWallets(wallets)
 	items := []cryptomaterial.DropDownItem{}
-	if len(wallets) > 0 {
-		isFirst := true
-		for _, w := range wallets {
-			if w.IsWatchingOnlyWallet() && !d.isWatchOnlyEnabled || d.walletIsValid != nil && !d.walletIsValid(w) {
-				continue
-			}
-			item := cryptomaterial.DropDownItem{
-				Text:      fmt.Sprint(w.GetWalletID()),
-				Icon:      d.Theme.AssetIcon(w.GetAssetType()),
-				DisplayFn: d.getWalletItemLayout(w),
-			}
-			items = append(items, item)
-			d.allWallets = append(d.allWallets, w)
-			if len(args) < 1 {
-				if isFirst { // select the first valid wallet
-					isFirst = false
-					d.selectedWallet = w
-				}
-			} else {
-				wallet := args[0]
-				if w != nil && wallet.GetWalletID() == w.GetWalletID() {
-					d.selectedWallet = w
-				}
-			}
+	enabled := make([]sharedW.Asset, 0, len(wallets))
+	for _, w := range wallets {
+		if w.IsWatchingOnlyWallet() && !d.isWatchOnlyEnabled || d.walletIsValid != nil && !d.walletIsValid(w) {
+			continue
+		}
+		// A hidden wallet is still listed if it was explicitly selected.
+		if w.IsHidden() && !d.includeHidden && (requested == nil || requested.GetWalletID() != w.GetWalletID()) {
+			continue
+		}
+		disabled, reason := d.isWalletDisabled(w)
+		item := cryptomaterial.DropDownItem{
+			Text:             fmt.Sprint(w.GetWalletID()),
+			Icon:             d.Theme.AssetIcon(w.GetAssetType()),
+			DisplayFn:        d.getWalletItemLayout(w, disabled, reason),
+			PreventSelection: disabled,
+		}
+		items = append(items, item)
+		d.allWallets = append(d.allWallets, w)
+		if !disabled {
+			enabled = append(enabled, w)
 		}
 	}
+	d.selectedWallet = defaultWallet(enabled, requested, startupWallet)
 	d.dropdown.SetItems(items)
+	if d.selectedWallet != nil {
+		d.dropdown.SetSelectedValue(fmt.Sprint(d.selectedWallet.GetWalletID()))
+	}
+	return d
+}
+
+// defaultWallet returns the wallet to select among the enabled wallets, in the
+// order they are listed. The requested wallet is preferred, then the startup
+// wallet and then the first enabled wallet. Nil is returned if no wallet is
+// enabled so that a wallet selected before isn't kept.
+func defaultWallet(enabled []sharedW.Asset, requested, startup sharedW.Asset) sharedW.Asset {
+	if len(enabled) == 0 {
+		return nil
+	}
+	for _, preferred := range []sharedW.Asset{requested, startup} {
+		if preferred == nil {
+			continue
+		}
+		for _, w := range enabled {
+			if w.GetWalletID() == preferred.GetWalletID() {
+				return w
+			}
+		}
+	}
+	return enabled[0]
+}
+
+// hasCustomOrder returns true if the user chose the order the wallets are
+// listed in.
+func (d *WalletDropdown) hasCustomOrder() bool {
+	return d.AssetsManager != nil && d.AssetsManager.HasCustomWalletOrder()
+}
+
+// nextSortOrder returns the sort order that follows the selected one. The
+// custom order is skipped if the user didn't order the wallets.
+func (d *WalletDropdown) nextSortOrder() walletSortOrder {
+	orders := walletSortOrder(2)
+	if d.hasCustomOrder() {
+		orders = 3
+	}
+	return (d.sortOrder + 1) % orders
+}
+
+// sortWallets orders wallets by name (A-Z), by descending balance or in the
+// order chosen by the user, depending on the selected sort order.
+func (d *WalletDropdown) sortWallets(wallets []sharedW.Asset) {
+	if d.sortOrder == sortWalletsByCustomOrder {
+		// The assets manager lists the wallets in the custom order.
+		return
+	}
+	if d.sortOrder == sortWalletsByBalance {
+		sortByBalance(wallets, func(wallet sharedW.Asset) float64 {
+			total, _ := d.walletBalance(wallet)
+			return wallet.ToAmount(total).ToCoin()
+		}, d.fiatRate)
+		return
+	}
+	sort.SliceStable(wallets, func(i, j int) bool {
+		return strings.ToLower(wallets[i].GetWalletName()) < strings.ToLower(wallets[j].GetWalletName())
+	})
+}
+
+// sortByBalance orders wallets by descending balance. Balances of different
+// assets are compared through their fiat value if the rates of all the listed
+// assets are available, otherwise the wallets are grouped by asset and only
+// sorted by balance within an asset.
+func sortByBalance(wallets []sharedW.Asset, balance func(sharedW.Asset) float64, fiatRate func(utils.AssetType) float64) {
+	useFiat := fiatRate != nil
+	for _, wallet := range wallets {
+		if useFiat && fiatRate(wallet.GetAssetType()) <= 0 {
+			useFiat = false
+		}
+	}
+
+	sort.SliceStable(wallets, func(i, j int) bool {
+		wi, wj := wallets[i], wallets[j]
+		if useFiat {
+			return balance(wi)*fiatRate(wi.GetAssetType()) > balance(wj)*fiatRate(wj.GetAssetType())
+		}
+		if wi.GetAssetType() != wj.GetAssetType() {
+			return wi.GetAssetType() < wj.GetAssetType()
+		}
+		return balance(wi) > balance(wj)
+	})
+}
+
+// FilterAsset restricts the listed wallets to those of the provided asset
+// types. Wallets of all asset types are listed if no asset type is provided.
+// Setup must be called again for the filter to apply.
+func (d *WalletDropdown) FilterAsset(assetTypes ...utils.AssetType) *WalletDropdown {
+	d.assetTypes = assetTypes
+	return d
+}
+
+// WalletDisabled sets a function that decides which of the listed wallets
+// can't be selected. Disabled wallets are still shown, grayed out with the
+// reason returned. Setup must be called again for it to apply.
+func (d *WalletDropdown) WalletDisabled(isDisabled func(sharedW.Asset) (bool, string)) *WalletDropdown {
+	d.walletDisabled = isDisabled
+	return d
+}
+
+// isWalletDisabled returns true and the reason if the wallet can't be
+// selected.
+func (d *WalletDropdown) isWalletDisabled(wallet sharedW.Asset) (bool, string) {
+	if d.walletDisabled == nil {
+		return false, ""
+	}
+	return d.walletDisabled(wallet)
+}
+
+// ShowTotalBalance displays the combined total and spendable balance of the
+// listed wallets above the dropdown, one row per asset. If fiatRate isn't nil,
+// the fiat equivalent of the total is displayed as well unless the rate of
+// one of the listed assets is unavailable.
+func (d *WalletDropdown) ShowTotalBalance(fiatRate func(utils.AssetType) float64) *WalletDropdown {
+	d.showTotal = true
+	d.fiatRate = fiatRate
+	return d
+}
+
+// IncludeHidden lists the wallets hidden by the user, they aren't listed by
+// default. Setup must be called again for it to apply.
+func (d *WalletDropdown) IncludeHidden(include bool) *WalletDropdown {
+	d.includeHidden = include
 	return d
 }
 
@@ -83,6 +259,13 @@ func (d *WalletDropdown) EnableWatchOnlyWallets(isEnable bool) *WalletDropdown {
 	return d
 }
 
+// SetPeriodicRefresh enables a periodic balance refresh while the tx
+// notification listener is active. An interval of zero disables it.
+func (d *WalletDropdown) SetPeriodicRefresh(interval time.Duration) *WalletDropdown {
+	d.balanceRefresher.interval = interval
+	return d
+}
+
 func (d *WalletDropdown) SetSelectedWallet(wallet sharedW.Asset) {
 	if wallet == nil {
 		return
@@ -91,7 +274,17 @@ func (d *WalletDropdown) SetSelectedWallet(wallet sharedW.Asset) {
 	d.dropdown.SetSelectedValue(fmt.Sprint(wallet.GetWalletID()))
 }
 
+// walletBalance returns the cached balance of the wallet. The balance is only
+// computed again once it has been invalidated by a notification or expired.
 func (d *WalletDropdown) walletBalance(wal sharedW.Asset) (totalBalance, spendableBalance int64) {
+	return d.balanceCache.balance(wal.GetWalletID(), func() (int64, int64) {
+		return computeWalletBalance(wal)
+	})
+}
+
+// computeWalletBalance returns the total and spendable balance of the
+// accounts of the wallet.
+func computeWalletBalance(wal sharedW.Asset) (totalBalance, spendableBalance int64) {
 	accountsResult, err := wal.GetAccountsRaw()
 	if err != nil {
 		log.Errorf("Error getting accounts: %s", err)
@@ -109,24 +302,54 @@ func (d *WalletDropdown) walletBalance(wal sharedW.Asset) (totalBalance, spendab
 	return tBal, sBal
 }
 
-func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset) layout.Widget {
+// getWalletItemLayout lays out a wallet item. Disabled wallets are grayed out
+// and show disabledReason in place of their spendable balance.
+func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset, disabled bool, disabledReason string) layout.Widget {
 	return func(gtx C) D {
 		totalBal, spendable := d.walletBalance(wallet)
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
 				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
-						lbl := d.Theme.SemiBoldLabel(wallet.GetWalletName())
-						lbl.MaxLines = 1
-						lbl.TextSize = values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
-						return lbl.Layout(gtx)
+						return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+							layout.Rigid(func(gtx C) D {
+								lbl := d.Theme.SemiBoldLabel(wallet.GetWalletName())
+								lbl.MaxLines = 1
+								lbl.TextSize = values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
+								if disabled {
+									lbl.Color = d.Theme.Color.GrayText3
+								}
+								return lbl.Layout(gtx)
+							}),
+							layout.Rigid(func(gtx C) D {
+								return layout.Inset{Left: values.MarginPadding6}.Layout(gtx, func(gtx C) D {
+									return d.assetBadgeLayout(gtx, wallet.GetAssetType())
+								})
+							}),
+						)
 					}),
 					layout.Rigid(func(gtx C) D {
-						return d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize16), wallet.ToAmount(totalBal).String()).Layout(gtx)
+						lbl := d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize16), utils.FormatAmount(wallet.GetAssetType(), totalBal))
+						if disabled {
+							lbl.Color = d.Theme.Color.GrayText3
+						}
+						return lbl.Layout(gtx)
 					}),
 				)
 			}),
 			layout.Rigid(func(gtx C) D {
+				if disabled {
+					lbl := d.Theme.Label(values.TextSize14, disabledReason)
+					lbl.Color = d.Theme.Color.GrayText3
+					return lbl.Layout(gtx)
+				}
+				if !wallet.CanSign() {
+					// Nothing can be spent from a watch-only wallet.
+					lbl := d.Theme.Label(values.TextSize14, values.String(values.StrWatchOnly))
+					lbl.Color = d.Theme.Color.GrayText2
+					return lbl.Layout(gtx)
+				}
+
 				return layout.Flex{Axis: layout.Horizontal, Spacing: layout.SpaceBetween}.Layout(gtx,
 					layout.Rigid(func(gtx C) D {
 						spendableText := d.Theme.Label(values.TextSize14, values.String(values.StrLabelSpendable))
@@ -134,7 +357,7 @@ func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset) layout.Widget
 						return spendableText.Layout(gtx)
 					}),
 					layout.Rigid(func(gtx C) D {
-						return d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize14), wallet.ToAmount(spendable).String()).Layout(gtx)
+						return d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize14), utils.FormatAmount(wallet.GetAssetType(), spendable)).Layout(gtx)
 					}),
 				)
 			}),
@@ -142,6 +365,24 @@ func (d *WalletDropdown) getWalletItemLayout(wallet sharedW.Asset) layout.Widget
 	}
 }
 
+// assetBadgeLayout displays the asset type in a small badge colored after the
+// asset, so wallets of different assets can be told apart.
+func (d *WalletDropdown) assetBadgeLayout(gtx C, assetType utils.AssetType) D {
+	lbl := d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize10), assetType.String())
+	lbl.Color = d.Theme.Color.White
+	lbl.Font.Weight = font.SemiBold
+	return cryptomaterial.LinearLayout{
+		Width:      cryptomaterial.WrapContent,
+		Height:     cryptomaterial.WrapContent,
+		Background: d.Theme.AssetBadgeColor(assetType),
+		Padding: layout.Inset{
+			Left:  values.MarginPadding4,
+			Right: values.MarginPadding4,
+		},
+		Border: cryptomaterial.Border{Radius: cryptomaterial.Radius(4)},
+	}.Layout2(gtx, lbl.Layout)
+}
+
 func (d *WalletDropdown) WalletValidator(walletIsValid func(sharedW.Asset) bool) *WalletDropdown {
 	d.walletIsValid = walletIsValid
 	return d
@@ -169,10 +410,19 @@ func (d *WalletDropdown) onChanged() {
 	}
 }
 
+// ListedWallets returns the wallets listed in the dropdown, in the order they
+// are displayed.
+func (d *WalletDropdown) ListedWallets() []sharedW.Asset {
+	return d.allWallets
+}
+
 func (d *WalletDropdown) SelectedWallet() sharedW.Asset {
 	return d.selectedWallet
 }
 
+// SetChangedCallback sets the function called when the user selects a wallet
+// in the dropdown. It isn't called when the dropdown is set up or its
+// balances are refreshed.
 func (d *WalletDropdown) SetChangedCallback(callback func(sharedW.Asset)) *WalletDropdown {
 	d.walletChangedCallback = callback
 	return d
@@ -182,53 +432,189 @@ func (d *WalletDropdown) Handle(gtx C) {
 	if d.dropdown.Changed(gtx) {
 		d.onChanged()
 	}
+
+	if d.sortToggle.Clicked(gtx) {
+		d.sortOrder = d.nextSortOrder()
+		d.Setup(d.selectedWallet)
+	}
 }
 
 func (d *WalletDropdown) Layout(gtx C, titleKey string) D {
 	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 		layout.Rigid(func(gtx C) D {
-			lbl := d.Theme.H6(values.String(titleKey))
-			lbl.TextSize = values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
-			lbl.Font.Weight = font.SemiBold
-			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+			return layout.Inset{Bottom: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						lbl := d.Theme.H6(values.String(titleKey))
+						lbl.TextSize = values.TextSizeTransform(d.IsMobileView(), values.TextSize16)
+						lbl.Font.Weight = font.SemiBold
+						return lbl.Layout(gtx)
+					}),
+					layout.Flexed(1, func(gtx C) D {
+						return layout.E.Layout(gtx, d.sortToggleLayout)
+					}),
+				)
+			})
+		}),
+		layout.Rigid(func(gtx C) D {
+			if !d.showTotal {
+				return D{}
+			}
+			return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, d.totalBalanceLayout)
 		}),
 		layout.Rigid(d.dropdown.Layout),
 	)
 }
 
+// assetTotals sums the balances of the listed wallets per asset, in the order
+// the assets are first listed. The cached wallet balances are used so the
+// totals are recomputed once a notification invalidates them.
+func (d *WalletDropdown) assetTotals() []assetTotal {
+	totals := make([]assetTotal, 0)
+	for _, wallet := range d.allWallets {
+		total, spendable := d.walletBalance(wallet)
+		i := 0
+		for i < len(totals) && totals[i].assetType != wallet.GetAssetType() {
+			i++
+		}
+		if i == len(totals) {
+			totals = append(totals, assetTotal{assetType: wallet.GetAssetType()})
+		}
+		totals[i].total += total
+		totals[i].spendable += spendable
+	}
+	return totals
+}
+
+// fiatTotal returns the USD value of totals. False is returned if the rate
+// of any of the assets is unavailable.
+func (d *WalletDropdown) fiatTotal(totals []assetTotal) (float64, bool) {
+	if d.fiatRate == nil || len(totals) == 0 {
+		return 0, false
+	}
+	var usd float64
+	for _, t := range totals {
+		rate := d.fiatRate(t.assetType)
+		if rate <= 0 {
+			return 0, false
+		}
+		usd += pageutils.CryptoToUSD(rate, pageutils.AssetAmount(t.assetType, t.total).ToCoin())
+	}
+	return usd, true
+}
+
+// totalBalanceLayout displays the combined balances of the listed wallets.
+func (d *WalletDropdown) totalBalanceLayout(gtx C) D {
+	totals := d.assetTotals()
+	if len(totals) == 0 {
+		return D{}
+	}
+
+	textSize14 := values.TextSizeTransform(d.IsMobileView(), values.TextSize14)
+	rows := []layout.FlexChild{
+		layout.Rigid(func(gtx C) D {
+			lbl := d.Theme.Label(textSize14, values.String(values.StrTotal))
+			lbl.Color = d.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}),
+	}
+	for _, t := range totals {
+		t := t
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Baseline, Spacing: layout.SpaceBetween}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return LayoutBalanceSize(gtx, d.Load, utils.FormatAmount(t.assetType, t.total), values.TextSizeTransform(d.IsMobileView(), values.TextSize16))
+				}),
+				layout.Rigid(func(gtx C) D {
+					spendable := fmt.Sprintf("%s: %s", values.String(values.StrLabelSpendable), utils.FormatAmount(t.assetType, t.spendable))
+					lbl := d.Theme.Label(textSize14, spendable)
+					lbl.Color = d.Theme.Color.GrayText2
+					return lbl.Layout(gtx)
+				}),
+			)
+		}))
+	}
+	if usd, ok := d.fiatTotal(totals); ok {
+		rows = append(rows, layout.Rigid(func(gtx C) D {
+			lbl := d.Theme.Label(textSize14, pageutils.FormatAsUSDString(d.Printer, usd))
+			lbl.Color = d.Theme.Color.GrayText2
+			return lbl.Layout(gtx)
+		}))
+	}
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx, rows...)
+}
+
+// sortToggleLayout displays the current sort order. Clicking it switches to
+// the next order.
+func (d *WalletDropdown) sortToggleLayout(gtx C) D {
+	orderKey := values.StrName
+	switch d.sortOrder {
+	case sortWalletsByBalance:
+		orderKey = values.StrTotalBalance
+	case sortWalletsByCustomOrder:
+		orderKey = values.StrCustomOrder
+	}
+	return d.sortToggle.Layout(gtx, func(gtx C) D {
+		lbl := d.Theme.Label(values.TextSizeTransform(d.IsMobileView(), values.TextSize14), values.StringF(values.StrSortedBy, values.String(orderKey)))
+		lbl.Color = d.Theme.Color.Primary
+		return lbl.Layout(gtx)
+	})
+}
+
 // ListenForTxNotifications listens for transaction and block updates and
 // updates the selector modal, if the modal is open at the time of the update.
 // The tx update listener MUST be unregistered using ws.StopTxNtfnListener()
 // when the page using this WalletAndAccountSelector widget is exited.
 func (d *WalletDropdown) ListenForTxNotifications(window app.WindowNavigator) {
+	// The refresh only updates the balances, the selection hasn't changed
+	// so the changed callback isn't called.
+	refreshBalance := func() {
+		if d.selectedWallet != nil {
+			d.balanceCache.invalidate(d.selectedWallet.GetWalletID())
+		}
+		window.Reload()
+	}
 	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
-		OnTransaction: func(_ int, _ *sharedW.Transaction) {
+		OnTransaction: func(walletID int, _ *sharedW.Transaction) {
 			// refresh wallets/Accounts list when new transaction is received
 			// only if selected wallet is not valid.
-			if d.walletChangedCallback != nil && d.selectedWallet != nil {
-				d.walletChangedCallback(d.selectedWallet)
-				window.Reload()
-			}
+			d.balanceCache.invalidate(walletID)
+			d.balanceRefresher.notify(refreshBalance)
 		},
-		OnBlockAttached: func(_ int, _ int32) {
+		OnBlockAttached: func(walletID int, _ int32) {
 			// refresh wallet and account balance on every new block
 			// only if sync is completed.
-			if d.walletChangedCallback != nil && d.selectedWallet != nil {
-				d.walletChangedCallback(d.selectedWallet)
-				window.Reload()
-			}
+			d.balanceCache.invalidate(walletID)
+			d.balanceRefresher.notify(refreshBalance)
 		},
 	}
 	if d.selectedWallet == nil {
 		return
 	}
+
+	// The balances of the other listed wallets only need to be invalidated.
+	for _, wallet := range d.allWallets {
+		if wallet.GetWalletID() == d.selectedWallet.GetWalletID() {
+			continue
+		}
+		if err := wallet.AddTxAndBlockNotificationListener(d.balanceCache.listener(), walletBalanceCacheID); err != nil {
+			log.Errorf("WalletDropdown balance cache listener error: %v", err)
+		}
+	}
+
 	err := d.selectedWallet.AddTxAndBlockNotificationListener(txAndBlockNotificationListener, WalletAndAccountSelectorID)
 	if err != nil {
 		log.Errorf("WalletAndAccountSelector.ListenForTxNotifications error: %v", err)
+		return
 	}
+	d.balanceRefresher.start(refreshBalance)
 }
 
 func (d *WalletDropdown) StopTxNtfnListener() {
+	d.balanceRefresher.stop()
+	for _, wallet := range d.allWallets {
+		wallet.RemoveTxAndBlockNotificationListener(walletBalanceCacheID)
+	}
 	if d.selectedWallet != nil {
 		d.selectedWallet.RemoveTxAndBlockNotificationListener(WalletAndAccountSelectorID)
 	}
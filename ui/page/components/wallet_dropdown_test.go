@@ -0,0 +1,122 @@
+package components
+
+import (
+	"testing"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// stubWallet only implements the wallet ID and asset type of the asset
+// interface.
+type stubWallet struct {
+	sharedW.Asset
+	id        int
+	assetType utils.AssetType
+}
+
+func (w *stubWallet) GetWalletID() int {
+	return w.id
+}
+
+func (w *stubWallet) GetAssetType() utils.AssetType {
+	return w.assetType
+}
+
+func TestDefaultWallet(t *testing.T) {
+	w1, w2, w3 := &stubWallet{id: 1}, &stubWallet{id: 2}, &stubWallet{id: 3}
+
+	tests := []struct {
+		name      string
+		enabled   []sharedW.Asset
+		requested sharedW.Asset
+		startup   sharedW.Asset
+		wantID    int
+	}{{
+		name:    "first enabled wallet",
+		enabled: []sharedW.Asset{w1, w2},
+		wantID:  1,
+	}, {
+		name:      "requested wallet",
+		enabled:   []sharedW.Asset{w1, w2},
+		requested: w2,
+		wantID:    2,
+	}, {
+		name:      "disabled requested wallet",
+		enabled:   []sharedW.Asset{w1, w2},
+		requested: w3,
+		wantID:    1,
+	}, {
+		name:    "startup wallet",
+		enabled: []sharedW.Asset{w1, w2},
+		startup: w2,
+		wantID:  2,
+	}, {
+		name:    "disabled startup wallet",
+		enabled: []sharedW.Asset{w2},
+		startup: w3,
+		wantID:  2,
+	}, {
+		name:      "all wallets disabled",
+		requested: w1,
+		startup:   w2,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := defaultWallet(test.enabled, test.requested, test.startup)
+			if test.wantID == 0 {
+				if got != nil {
+					t.Fatalf("expected no wallet, got wallet %d", got.GetWalletID())
+				}
+				return
+			}
+			if got == nil || got.GetWalletID() != test.wantID {
+				t.Fatalf("expected wallet %d, got %v", test.wantID, got)
+			}
+		})
+	}
+}
+
+func TestSortByBalance(t *testing.T) {
+	dcr1 := &stubWallet{id: 1, assetType: utils.DCRWalletAsset}
+	dcr2 := &stubWallet{id: 2, assetType: utils.DCRWalletAsset}
+	btc := &stubWallet{id: 3, assetType: utils.BTCWalletAsset}
+	balances := map[int]float64{1: 10, 2: 50, 3: 1}
+	balance := func(w sharedW.Asset) float64 { return balances[w.GetWalletID()] }
+	rates := map[utils.AssetType]float64{utils.DCRWalletAsset: 15, utils.BTCWalletAsset: 200}
+
+	tests := []struct {
+		name     string
+		fiatRate func(utils.AssetType) float64
+		want     []int
+	}{{
+		name:     "fiat value",
+		fiatRate: func(asset utils.AssetType) float64 { return rates[asset] },
+		want:     []int{2, 3, 1},
+	}, {
+		name: "grouped by asset without rates",
+		want: []int{3, 2, 1},
+	}, {
+		name: "grouped by asset if a rate is missing",
+		fiatRate: func(asset utils.AssetType) float64 {
+			if asset == utils.BTCWalletAsset {
+				return 0
+			}
+			return rates[asset]
+		},
+		want: []int{3, 2, 1},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wallets := []sharedW.Asset{dcr1, btc, dcr2}
+			sortByBalance(wallets, balance, test.fiatRate)
+			for i, w := range wallets {
+				if w.GetWalletID() != test.want[i] {
+					t.Fatalf("expected wallets %v, got wallet %d at %d", test.want, w.GetWalletID(), i)
+				}
+			}
+		})
+	}
+}
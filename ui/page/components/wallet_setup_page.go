@@ -2,6 +2,7 @@ package components
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"gioui.org/font"
@@ -49,6 +50,7 @@ type CreateWallet struct {
 	assetTypeError        cryptomaterial.Label
 	walletName            cryptomaterial.Editor
 	watchOnlyWalletHex    cryptomaterial.Editor
+	birthdayHeight        cryptomaterial.Editor
 	passwordEditor        cryptomaterial.Editor
 	confirmPasswordEditor cryptomaterial.Editor
 	watchOnlyCheckBox     cryptomaterial.CheckBoxStyle
@@ -107,6 +109,9 @@ func NewCreateWallet(l *load.Load, walletCreationSuccessCallback func(newWallet
 	pg.watchOnlyWalletHex = l.Theme.Editor(new(widget.Editor), values.String(values.StrExtendedPubKey))
 	pg.watchOnlyWalletHex.Editor.SingleLine, pg.watchOnlyWalletHex.Editor.Submit, pg.watchOnlyWalletHex.IsTitleLabel = false, true, false
 
+	pg.birthdayHeight = l.Theme.Editor(new(widget.Editor), values.String(values.StrBirthdayHeight))
+	pg.birthdayHeight.Editor.SingleLine, pg.birthdayHeight.Editor.Submit = true, true
+
 	pg.passwordEditor = l.Theme.EditorPassword(new(widget.Editor), values.String(values.StrSpendingPassword))
 	pg.passwordEditor.Editor.SingleLine, pg.passwordEditor.Editor.Submit = true, true
 
@@ -397,6 +402,14 @@ func (pg *CreateWallet) restoreWallet(gtx C) D {
 								}.Layout(gtx, pg.Theme.Label(textSize16, values.String(values.StrExtendedPubKey)).Layout)
 							}),
 							layout.Rigid(pg.watchOnlyWalletHex.Layout),
+							layout.Rigid(func(gtx C) D {
+								// Only BTC wallets can be imported with a
+								// birthday height.
+								if !pg.isBTCSelected() {
+									return D{}
+								}
+								return layout.Inset{Top: values.MarginPadding14}.Layout(gtx, pg.birthdayHeight.Layout)
+							}),
 						)
 					}),
 				)
@@ -423,13 +436,14 @@ func (pg *CreateWallet) restoreWallet(gtx C) D {
 }
 
 func (pg *CreateWallet) handleEditorEvents(gtx C) {
-	isSubmit, isChanged := cryptomaterial.HandleEditorEvents(gtx, &pg.watchOnlyWalletHex, &pg.walletName, &pg.passwordEditor, &pg.confirmPasswordEditor)
+	isSubmit, isChanged := cryptomaterial.HandleEditorEvents(gtx, &pg.watchOnlyWalletHex, &pg.birthdayHeight, &pg.walletName, &pg.passwordEditor, &pg.confirmPasswordEditor)
 	if isChanged {
 		// reset error when any editor is modified
 		pg.walletName.SetError("")
 		pg.passwordEditor.SetError("")
 		pg.confirmPasswordEditor.SetError("")
 		pg.watchOnlyWalletHex.SetError("")
+		pg.birthdayHeight.SetError("")
 	}
 
 	// create wallet action
@@ -459,7 +473,7 @@ func (pg *CreateWallet) handleEditorEvents(gtx C) {
 				var walletWithXPub int
 				walletWithXPub, err = pg.AssetsManager.BTCWalletWithXPub(pg.watchOnlyWalletHex.Editor.Text())
 				if walletWithXPub == -1 {
-					newWallet, err = pg.AssetsManager.CreateNewBTCWatchOnlyWallet(pg.walletName.Editor.Text(), pg.watchOnlyWalletHex.Editor.Text())
+					newWallet, err = pg.AssetsManager.CreateNewBTCWatchOnlyWallet(pg.walletName.Editor.Text(), pg.watchOnlyWalletHex.Editor.Text(), pg.birthdayHeightValue())
 				} else {
 					err = errors.New(values.String(values.StrXpubWalletExist))
 				}
@@ -655,5 +669,36 @@ func (pg *CreateWallet) validRestoreWalletInputs() bool {
 		return false
 	}
 
+	if pg.watchOnlyCheckBox.CheckBox.Value && pg.isBTCSelected() {
+		if _, ok := parseBirthdayHeight(pg.birthdayHeight.Editor.Text()); !ok {
+			pg.birthdayHeight.SetError(values.String(values.StrInvalidBirthdayHeight))
+			return false
+		}
+	}
+
 	return true
 }
+
+func (pg *CreateWallet) isBTCSelected() bool {
+	return strings.ToLower(pg.assetTypeDropdown.Selected()) == libutils.BTCWalletAsset.ToStringLower()
+}
+
+// birthdayHeightValue returns the birthday height entered, 0 if none was.
+func (pg *CreateWallet) birthdayHeightValue() int32 {
+	height, _ := parseBirthdayHeight(pg.birthdayHeight.Editor.Text())
+	return height
+}
+
+// parseBirthdayHeight parses the birthday height of a watch-only wallet. An
+// empty height is 0, the wallet is scanned from the genesis block.
+func parseBirthdayHeight(text string) (int32, bool) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return 0, true
+	}
+	height, err := strconv.ParseInt(text, 10, 32)
+	if err != nil || height < 0 {
+		return 0, false
+	}
+	return int32(height), true
+}
@@ -0,0 +1,26 @@
+package components
+
+import "testing"
+
+func TestParseBirthdayHeight(t *testing.T) {
+	tests := []struct {
+		text   string
+		height int32
+		ok     bool
+	}{
+		{"", 0, true},
+		{"  ", 0, true},
+		{"0", 0, true},
+		{" 840000 ", 840000, true},
+		{"-1", 0, false},
+		{"12.5", 0, false},
+		{"abc", 0, false},
+		{"99999999999", 0, false},
+	}
+	for _, test := range tests {
+		height, ok := parseBirthdayHeight(test.text)
+		if height != test.height || ok != test.ok {
+			t.Errorf("parseBirthdayHeight(%q): expected (%d, %v), got (%d, %v)", test.text, test.height, test.ok, height, ok)
+		}
+	}
+}
@@ -40,8 +40,15 @@ type WalletSyncInfo struct {
 	statusMu          sync.RWMutex
 
 	switchEnabled atomic.Bool
+
+	rescanFeed *sharedW.RescanProgressFeed
+	rescanMu   sync.Mutex
 }
 
+// rescanProgressInterval is the minimum time between two redraws caused by
+// rescan progress updates.
+const rescanProgressInterval = 500 * time.Millisecond
+
 // SyncInfo is made independent of the WalletSyncInfo struct so that once
 // set with a value, it persists till unset or the app is killed. This
 // will help address the progress bar issue where, changing UI pages alters the
@@ -282,18 +289,26 @@ func (wsi *WalletSyncInfo) rescanDetailsLayout(gtx C, inset layout.Inset) D {
 					layout.Rigid(func(gtx C) D {
 						return inset.Layout(gtx, func(gtx C) D {
 							headersFetchedTitleLabel := wsi.labelTexSize16Layout(values.String(values.StrBlocksScanned), 0, true)
-							blocksScannedLabel := wsi.labelTexSize16Layout(fmt.Sprint(rescanUpdate.CurrentRescanHeight), 0, false)
+							blocksScannedLabel := wsi.labelTexSize16Layout(fmt.Sprint(rescanUpdate.ScannedHeight), 0, false)
 							return EndToEndRow(gtx, headersFetchedTitleLabel, blocksScannedLabel)
 						})
 					}),
 					layout.Rigid(func(gtx C) D {
 						return inset.Layout(gtx, func(gtx C) D {
 							progressTitleLabel := wsi.labelTexSize16Layout(values.String(values.StrSyncingProgress), 0, true)
-							rescanProgress := values.StringF(values.StrBlocksLeft, rescanUpdate.TotalHeadersToScan-rescanUpdate.CurrentRescanHeight)
+							rescanProgress := values.StringF(values.StrBlocksLeft, rescanUpdate.TargetHeight-rescanUpdate.ScannedHeight)
 							blocksScannedLabel := wsi.labelTexSize16Layout(rescanProgress, 0, false)
 							return EndToEndRow(gtx, progressTitleLabel, blocksScannedLabel)
 						})
 					}),
+					layout.Rigid(func(gtx C) D {
+						return inset.Layout(gtx, func(gtx C) D {
+							timeLeftTitleLabel := wsi.labelTexSize16Layout(values.String(values.StrSyncCompTime), 0, true)
+							_, timeLeft := wsi.progressStatusDetails()
+							timeLeftLabel := wsi.labelTexSize16Layout(timeLeft, 0, false)
+							return EndToEndRow(gtx, timeLeftTitleLabel, timeLeftLabel)
+						})
+					}),
 				)
 			})
 		})
@@ -503,6 +518,11 @@ func (wsi *WalletSyncInfo) progressStatusDetails() (progress int, timeLeft strin
 	progress = sp.SyncProgress()
 	timeLeft = sp.RemainingSyncTime()
 
+	if rescanUpdate := wsi.FetchRescanUpdate(); rescanUpdate != nil && !wsi.wallet.IsSyncing() {
+		progress = int(rescanUpdate.Percent())
+		timeLeft = pageutils.TimeFormat(int(rescanUpdate.ETA.Seconds()), true)
+	}
+
 	if wsi.wallet.IsSyncing() || wsi.wallet.IsRescanning() {
 		timeLeft = values.StringF(values.StrTimeLeftFmt, timeLeft)
 		if progress == 0 {
@@ -537,12 +557,13 @@ func (wsi *WalletSyncInfo) FetchSyncProgress() pageutils.ProgressInfo {
 // FetchRescanUpdate returns the rescan update if the wallet is rescanning and
 // an update exists. If rescanning isn't running, clear the rescan data for the
 // current asset type
-func (wsi *WalletSyncInfo) FetchRescanUpdate() *sharedW.HeadersRescanProgressReport {
+func (wsi *WalletSyncInfo) FetchRescanUpdate() *sharedW.RescanProgress {
 	walletIsRescanning := wsi.wallet.IsRescanning()
 	isRescanUpdateAvailable := syncProgressInfo.IsRescanProgressSet(wsi.wallet)
 
 	if walletIsRescanning && isRescanUpdateAvailable {
-		return syncProgressInfo.GetRescanProgress(wsi.wallet)
+		progress := syncProgressInfo.GetRescanProgress(wsi.wallet)
+		return &progress
 	}
 
 	if !walletIsRescanning {
@@ -631,18 +652,37 @@ func (wsi *WalletSyncInfo) ListenForNotifications() {
 		return
 	}
 
-	blocksRescanProgressListener := &sharedW.BlocksRescanProgressListener{
-		OnBlocksRescanStarted: func(_ int) {},
-		OnBlocksRescanProgress: func(progress *sharedW.HeadersRescanProgressReport) {
+	wsi.listenForRescanProgress()
+}
+
+// listenForRescanProgress renders the progress of the next rescan of the
+// wallet. A new feed is registered once the rescan ends so that subsequent
+// rescans are displayed too, until StopListeningForNotifications is called.
+func (wsi *WalletSyncInfo) listenForRescanProgress() {
+	feed := sharedW.NewRescanProgressFeed(rescanProgressInterval)
+	wsi.rescanMu.Lock()
+	wsi.rescanFeed = feed
+	wsi.rescanMu.Unlock()
+	wsi.wallet.SetBlocksRescanProgressListener(feed.Listener())
+
+	go func() {
+		for progress := range feed.Progress() {
 			syncProgressInfo.SetRescanProgress(wsi.wallet, progress)
 			wsi.reload()
-		},
-		OnBlocksRescanEnded: func(_ int, _ error) {
-			syncProgressInfo.DeleteRescanProgress(wsi.wallet)
-			wsi.reload()
-		},
-	}
-	wsi.wallet.SetBlocksRescanProgressListener(blocksRescanProgressListener)
+		}
+		if err := feed.Err(); err != nil {
+			log.Errorf("Rescan of wallet %s failed: %v", wsi.wallet.GetWalletName(), err)
+		}
+		syncProgressInfo.DeleteRescanProgress(wsi.wallet)
+		wsi.reload()
+
+		wsi.rescanMu.Lock()
+		isListening := wsi.rescanFeed == feed
+		wsi.rescanMu.Unlock()
+		if isListening {
+			wsi.listenForRescanProgress()
+		}
+	}()
 }
 
 // StopListeningForNotifications stops listening for sync progress, tx and block
@@ -651,6 +691,14 @@ func (wsi *WalletSyncInfo) StopListeningForNotifications() {
 	wsi.wallet.RemoveSyncProgressListener(WalletSyncInfoID)
 	wsi.wallet.RemoveTxAndBlockNotificationListener(WalletSyncInfoID)
 	wsi.wallet.SetBlocksRescanProgressListener(nil)
+
+	wsi.rescanMu.Lock()
+	feed := wsi.rescanFeed
+	wsi.rescanFeed = nil
+	wsi.rescanMu.Unlock()
+	if feed != nil {
+		feed.Close(nil)
+	}
 }
 
 // HandleUserInteractions is called just before Layout() to determine
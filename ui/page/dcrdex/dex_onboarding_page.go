@@ -1192,6 +1192,7 @@ func (pg *DEXOnboarding) connectServerAndPrepareForBonding() {
 		WalletValidator(func(a sharedW.Asset) bool {
 			return !a.IsWatchingOnlyWallet() && pg.validateBondWalletOrAccount(a.GetAssetType(), dexc.WalletIDConfigKey, fmt.Sprint(a.GetWalletID()))
 		}).
+		ShowTotalBalance(pg.cachedUSDRate).
 		Setup()
 	pg.bondSourceAccountSelector = components.NewAccountDropdown(pg.Load).
 		AccountValidator(func(a *sharedW.Account) bool {
@@ -1528,3 +1529,20 @@ func convertAssetIDToAssetType(assetID uint32) libutils.AssetType {
 		return assetTypeNoAsset
 	}
 }
+
+// cachedUSDRate returns the last fetched USD rate of the asset, or zero if
+// there is none or exchange rate fetching is disabled.
+func (pg *DEXOnboarding) cachedUSDRate(assetType libutils.AssetType) float64 {
+	if !pg.AssetsManager.ExchangeRateFetchingEnabled() {
+		return 0
+	}
+	market, err := utils.USDMarketFromAsset(assetType)
+	if err != nil {
+		return 0
+	}
+	ticker := pg.AssetsManager.RateSource.GetTicker(market, true)
+	if ticker == nil {
+		return 0
+	}
+	return ticker.LastTradePrice
+}
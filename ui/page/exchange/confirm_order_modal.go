@@ -23,6 +23,10 @@ import (
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
+// placeOrderOperationID identifies an order being placed in the pending
+// operations registry.
+const placeOrderOperationID = "exchange_place_order"
+
 type confirmOrderModal struct {
 	*load.Load
 	*cryptomaterial.Modal
@@ -106,9 +110,11 @@ func (com *confirmOrderModal) confirmOrder() {
 	}
 
 	com.setLoading(true)
+	com.PendingOperations.Add(placeOrderOperationID, values.String(values.StrPlacingOrder))
 	go func() {
 		var err error
 		defer func() {
+			com.PendingOperations.Remove(placeOrderOperationID)
 			if err != nil {
 				com.setLoading(false)
 			}
@@ -3,6 +3,10 @@ package governance
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"gioui.org/font"
@@ -10,7 +14,6 @@ import (
 	"gioui.org/widget"
 
 	"github.com/crypto-power/cryptopower/app"
-	"github.com/crypto-power/cryptopower/libwallet"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
@@ -24,10 +27,40 @@ import (
 
 const TreasuryPageID = "Treasury"
 
-const (
-	mainnetParamsHost = "https://github.com/decred/dcrd/blob/master/chaincfg/mainnetparams.go#L477"
-	testnetParamsHost = "https://github.com/decred/dcrd/blob/master/chaincfg/testnetparams.go#L390"
-)
+// noPiKeysModalKey avoids stacking identical error modals when the policies
+// can't be fetched because the network has no Pi keys.
+const noPiKeysModalKey = "treasury_no_pi_keys"
+
+// treasuryPiKey returns the hex encoded Pi key whose policies are displayed.
+// An error is returned if the network params have no Pi keys.
+func treasuryPiKey(piKeys [][]byte) (string, error) {
+	if len(piKeys) == 0 || len(piKeys[0]) == 0 {
+		return "", errors.New("no Pi keys available")
+	}
+	return hex.EncodeToString(piKeys[0]), nil
+}
+
+// chaincfgURL is the location of the dcrd network parameters, which include
+// the governance (Pi) keys of each network.
+const chaincfgURL = "https://github.com/decred/dcrd/blob/master/chaincfg"
+
+// governanceKeysURL returns the link to the dcrd parameters that define the
+// governance keys of the network. Networks without a parameters file of their
+// own link to the chaincfg package.
+func governanceKeysURL(net libutils.NetworkType) string {
+	switch net {
+	case libutils.Mainnet:
+		return chaincfgURL + "/mainnetparams.go"
+	case libutils.Testnet:
+		return chaincfgURL + "/testnetparams.go"
+	case libutils.Simulation, libutils.DEXTest:
+		return chaincfgURL + "/simnetparams.go"
+	case libutils.Regression:
+		return chaincfgURL + "/regnetparams.go"
+	default:
+		return chaincfgURL
+	}
+}
 
 type TreasuryPage struct {
 	*load.Load
@@ -46,6 +79,8 @@ type TreasuryPage struct {
 	selectedDCRWallet *dcr.Asset
 
 	treasuryItems []*components.TreasuryItem
+	// filteredItems are the treasuryItems matching the search query.
+	filteredItems []*components.TreasuryItem
 
 	listContainer      *widget.List
 	viewGovernanceKeys *cryptomaterial.Clickable
@@ -56,8 +91,13 @@ type TreasuryPage struct {
 	infoButton   cryptomaterial.IconButton
 
 	isPolicyFetchInProgress bool
-	navigateToSettingsBtn   cryptomaterial.Button
-	createWalletBtn         cryptomaterial.Button
+	// reloadScheduled is set while a reload of the window is scheduled to
+	// refresh the display during a policy fetch.
+	reloadScheduled uint32
+	// loadPolicies fetches the policies of the selected wallet.
+	loadPolicies          func() ([]*dcr.TreasuryKeyPolicy, error)
+	navigateToSettingsBtn cryptomaterial.Button
+	createWalletBtn       cryptomaterial.Button
 
 	PiKey string
 }
@@ -75,6 +115,12 @@ func NewTreasuryPage(l *load.Load) *TreasuryPage {
 		createWalletBtn:    l.Theme.Button(values.String(values.StrCreateANewWallet)),
 	}
 
+	pg.loadPolicies = func() ([]*dcr.TreasuryKeyPolicy, error) {
+		return libutils.Retry(pg.ctx, libutils.DefaultRetryPolicy, func() ([]*dcr.TreasuryKeyPolicy, error) {
+			return pg.selectedDCRWallet.TreasuryPolicies(pg.PiKey, "")
+		})
+	}
+
 	pg.searchEditor = l.Theme.IconEditor(new(widget.Editor), values.String(values.StrSearch), l.Theme.Icons.SearchIcon, true)
 	pg.searchEditor.Editor.SingleLine, pg.searchEditor.Editor.Submit, pg.searchEditor.Bordered = true, true, false
 
@@ -94,7 +140,8 @@ func (pg *TreasuryPage) OnNavigatedTo() {
 	pg.initWalletSelector()
 	// Fetch (or re-fetch) treasury policies in background as this makes
 	// a network call. Refresh the window once the call completes.
-	pg.PiKey = hex.EncodeToString(pg.AssetsManager.PiKeys()[0])
+	// FetchPolicies reports a missing Pi key.
+	pg.PiKey, _ = treasuryPiKey(pg.AssetsManager.PiKeys())
 
 	if pg.isTreasuryAPIAllowed() && pg.selectedDCRWallet != nil {
 		pg.FetchPolicies()
@@ -138,6 +185,9 @@ func (pg *TreasuryPage) HandleUserInteractions(gtx C) {
 		if pg.treasuryItems[i].SetChoiceButton.Clicked(gtx) {
 			pg.updatePolicyPreference(pg.treasuryItems[i])
 		}
+		if pg.treasuryItems[i].HistoryToggle.Clicked(gtx) {
+			pg.treasuryItems[i].ShowHistory = !pg.treasuryItems[i].ShowHistory
+		}
 	}
 
 	if pg.walletDropDown != nil && pg.walletDropDown.Changed(gtx) {
@@ -159,10 +209,7 @@ func (pg *TreasuryPage) HandleUserInteractions(gtx C) {
 	}
 
 	if pg.viewGovernanceKeys.Clicked(gtx) {
-		host := mainnetParamsHost
-		if pg.AssetsManager.NetType() == libwallet.Testnet {
-			host = testnetParamsHost
-		}
+		host := governanceKeysURL(pg.AssetsManager.NetType())
 
 		info := modal.NewCustomModal(pg.Load).
 			Title(values.String(values.StrVerifyGovernanceKeys)).
@@ -175,8 +222,11 @@ func (pg *TreasuryPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(info)
 	}
 
-	if pg.isPolicyFetchInProgress {
+	// Keep refreshing the display while the policies are fetched, scheduling
+	// one reload at a time. No reload is scheduled once the fetch completes.
+	if pg.isPolicyFetchInProgress && atomic.CompareAndSwapUint32(&pg.reloadScheduled, 0, 1) {
 		time.AfterFunc(time.Second*1, func() {
+			atomic.StoreUint32(&pg.reloadScheduled, 0)
 			pg.ParentWindow().Reload()
 		})
 	}
@@ -187,17 +237,73 @@ func (pg *TreasuryPage) HandleUserInteractions(gtx C) {
 		}, libutils.DCRWalletAsset))
 	}
 
-	pg.searchEditor.EditorIconButtonEvent = func() {
-		// TODO: treasury search functionality
+	pg.searchEditor.EditorIconButtonEvent = pg.filterPolicies
+	pg.handleEditorEvents(gtx)
+}
+
+func (pg *TreasuryPage) handleEditorEvents(gtx C) {
+	for {
+		event, ok := pg.searchEditor.Editor.Update(gtx)
+		if !ok {
+			break
+		}
+
+		switch event.(type) {
+		case widget.ChangeEvent, widget.SubmitEvent:
+			pg.filterPolicies()
+		}
+	}
+}
+
+// filterPolicies displays the policies matching the search query. Clearing
+// the query displays all the policies.
+func (pg *TreasuryPage) filterPolicies() {
+	pg.filteredItems = components.FilterTreasuryItems(pg.treasuryItems, pg.searchEditor.Editor.Text())
+}
+
+// displayPolicies builds the list items of the policies and displays those
+// matching the search query.
+func (pg *TreasuryPage) displayPolicies(policies []*dcr.TreasuryKeyPolicy) {
+	items := components.NewTreasuryItems(pg.Load, policies)
+	if pg.selectedDCRWallet != nil {
+		for _, item := range items {
+			item.History = pg.selectedDCRWallet.TreasuryPolicyHistory(item.Policy.PiKey)
+		}
 	}
+	pg.treasuryItems = items
+	pg.filterPolicies()
 }
 
+// FetchPolicies refreshes the policies of the selected wallet in the
+// background. The policies last fetched for the wallet, if any, are displayed
+// until the refresh completes.
 func (pg *TreasuryPage) FetchPolicies() {
+	if pg.PiKey == "" {
+		errModal := modal.NewErrorModal(pg.Load, values.String(values.StrNoPiKeys), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModalOnce(noPiKeysModalKey, errModal)
+		return
+	}
+
 	pg.isPolicyFetchInProgress = true
 
+	wallet := pg.selectedDCRWallet
+	if wallet != nil {
+		cached, _ := policyCache.get(wallet.NetType(), wallet.GetWalletID())
+		pg.displayPolicies(cached)
+	}
+
 	go func() {
-		pg.treasuryItems = components.LoadPolicies(pg.Load, pg.selectedDCRWallet, pg.PiKey)
-		pg.isPolicyFetchInProgress = true
+		policies, err := pg.loadPolicies()
+		if err != nil {
+			// Keep displaying the cached policies, if any.
+			log.Errorf("Error fetching treasury policies: %v", err)
+		} else {
+			pg.displayPolicies(policies)
+			if wallet != nil {
+				policyCache.set(wallet.NetType(), wallet.GetWalletID(), policies)
+			}
+		}
+		pg.isPolicyFetchInProgress = false
 		pg.ParentWindow().Reload()
 	}()
 
@@ -304,16 +410,33 @@ func (pg *TreasuryPage) layoutVerifyGovernanceKeys(gtx C) D {
 }
 
 func (pg *TreasuryPage) layoutContent(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(func(gtx C) D {
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.searchEditor.Layout)
+		}),
+		layout.Flexed(1, func(gtx C) D {
+			if len(pg.filteredItems) == 0 {
+				return components.LayoutNoPoliciesFound(gtx, pg.Load, pg.isPolicyFetchInProgress)
+			}
+			return pg.layoutPolicies(gtx)
+		}),
+	)
+}
+
+func (pg *TreasuryPage) layoutPolicies(gtx C) D {
+	items := pg.filteredItems
 	return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
 		list := layout.List{Axis: layout.Vertical}
 		return pg.Theme.List(pg.listContainer).Layout(gtx, 1, func(gtx C, _ int) D {
-			return list.Layout(gtx, len(pg.treasuryItems), func(gtx C, i int) D {
+			return list.Layout(gtx, len(items), func(gtx C, i int) D {
 				return layout.Inset{Top: values.MarginPadding16, Bottom: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
-						layout.Rigid(pg.layoutPiKey),
+						layout.Rigid(func(gtx C) D {
+							return pg.layoutPiKey(gtx, items[i].Policy.PiKey)
+						}),
 						layout.Rigid(func(gtx C) D {
 							return layout.Inset{Top: values.MarginPadding24}.Layout(gtx, func(gtx C) D {
-								return components.TreasuryItemWidget(gtx, pg.Load, pg.treasuryItems[i])
+								return components.TreasuryItemWidget(gtx, pg.Load, items[i])
 							})
 						}),
 					)
@@ -323,7 +446,7 @@ func (pg *TreasuryPage) layoutContent(gtx C) D {
 	})
 }
 
-func (pg *TreasuryPage) layoutPiKey(gtx C) D {
+func (pg *TreasuryPage) layoutPiKey(gtx C, piKey string) D {
 	backgroundColor := pg.Theme.Color.LightBlue
 	if pg.AssetsManager.IsDarkModeOn() {
 		backgroundColor = pg.Theme.Color.Background
@@ -350,18 +473,30 @@ func (pg *TreasuryPage) layoutPiKey(gtx C) D {
 					Left:   values.MarginPadding8,
 					Right:  values.MarginPadding8,
 				},
-			}.Layout2(gtx, pg.Theme.Label(pg.ConvertTextSize(values.TextSize14), pg.PiKey).Layout)
+			}.Layout2(gtx, pg.Theme.Label(pg.ConvertTextSize(values.TextSize14), piKey).Layout)
 		}),
 	)
 }
 
 func (pg *TreasuryPage) updatePolicyPreference(treasuryItem *components.TreasuryItem) {
+	applyToAll := new(widget.Bool)
+	applyToAllCheckBox := pg.Theme.CheckBox(applyToAll, values.String(values.StrApplyToAllWallets))
 	passwordModal := modal.NewCreatePasswordModal(pg.Load).
 		EnableName(false).
 		EnableConfirmPassword(false).
 		Title(values.String(values.StrConfirmVote)).
+		UseCustomWidget(func(gtx C) D {
+			if len(pg.assetWallets) < 2 {
+				return D{}
+			}
+			return applyToAllCheckBox.Layout(gtx)
+		}).
 		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
 			votingPreference := treasuryItem.OptionsRadioGroup.Value
+			if applyToAll.Value && len(pg.assetWallets) > 1 {
+				return pg.setPolicyOnAllWallets(treasuryItem.Policy.PiKey, votingPreference, password, pm)
+			}
+
 			err := pg.selectedDCRWallet.SetTreasuryPolicy(treasuryItem.Policy.PiKey, votingPreference, "", password)
 			if err != nil {
 				pm.SetError(err.Error())
@@ -378,6 +513,50 @@ func (pg *TreasuryPage) updatePolicyPreference(treasuryItem *components.Treasury
 	pg.ParentWindow().ShowModal(passwordModal)
 }
 
+// setPolicyOnAllWallets sets the policy on every DCR wallet using the same
+// passphrase. Watching-only wallets and wallets the policy can't be set on
+// are skipped and listed in the summary shown once done.
+func (pg *TreasuryPage) setPolicyOnAllWallets(piKey, votingPreference, password string, pm *modal.CreatePasswordModal) bool {
+	var skipped []string
+	for _, wallet := range pg.assetWallets {
+		dcrWallet, ok := wallet.(*dcr.Asset)
+		if !ok {
+			continue
+		}
+
+		if dcrWallet.IsWatchingOnlyWallet() {
+			skipped = append(skipped, fmt.Sprintf("%s: %s", dcrWallet.GetWalletName(), values.String(values.StrWatchOnly)))
+			continue
+		}
+
+		if err := dcrWallet.SetTreasuryPolicy(piKey, votingPreference, "", password); err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", dcrWallet.GetWalletName(), err))
+		}
+	}
+
+	if len(skipped) == len(pg.assetWallets) {
+		pm.SetError(strings.Join(skipped, "\n"))
+		return false
+	}
+
+	pg.FetchPolicies() // re-fetch policies when voting is done.
+	pm.Dismiss()
+
+	if len(skipped) == 0 {
+		infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrPolicySetSuccessful), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(infoModal)
+		return true
+	}
+
+	summary := values.StringF(values.StrPolicySetOnSomeWallets, len(pg.assetWallets)-len(skipped), len(pg.assetWallets), strings.Join(skipped, "\n"))
+	infoModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrConfirmVote)).
+		Body(summary).
+		SetPositiveButtonText(values.String(values.StrOk))
+	pg.ParentWindow().ShowModal(infoModal)
+	return true
+}
+
 // TODO: Temporary UI. Pending when new designs will be ready for this feature
 func (pg *TreasuryPage) decredWalletRequired(gtx C) D {
 	return cryptomaterial.LinearLayout{
@@ -0,0 +1,168 @@
+package governance
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/assets"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+)
+
+// policyKeys returns the Pi keys of the items, failing the test if the
+// choice selected on an item isn't its saved policy.
+func policyKeys(t *testing.T, items []*components.TreasuryItem) []string {
+	t.Helper()
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.OptionsRadioGroup.Value != item.Policy.Policy {
+			t.Fatalf("%s: expected the %q choice to be selected, got %q", item.Policy.PiKey, item.Policy.Policy, item.OptionsRadioGroup.Value)
+		}
+		keys = append(keys, item.Policy.PiKey)
+	}
+	return keys
+}
+
+func TestFetchPolicies(t *testing.T) {
+	displayed := []*dcr.TreasuryKeyPolicy{{PiKey: "03aaaa", Policy: "no"}}
+	fetched := []*dcr.TreasuryKeyPolicy{{PiKey: "03f6e7", Policy: "yes"}, {PiKey: "0319ab", Policy: "abstain"}}
+
+	tests := []struct {
+		name         string
+		query        string
+		err          error
+		wantItems    []string
+		wantFiltered []string
+	}{{
+		name:         "policies fetched",
+		wantItems:    []string{"03f6e7", "0319ab"},
+		wantFiltered: []string{"03f6e7", "0319ab"},
+	}, {
+		name:         "search query applied",
+		query:        "yes",
+		wantItems:    []string{"03f6e7", "0319ab"},
+		wantFiltered: []string{"03f6e7"},
+	}, {
+		name:         "fetch failed",
+		err:          errors.New("fetch failed"),
+		wantItems:    []string{"03aaaa"},
+		wantFiltered: []string{"03aaaa"},
+	}}
+
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reloaded := make(chan struct{}, 2)
+			var searchEditor cryptomaterial.Editor
+			searchEditor.Editor = new(widget.Editor)
+			pg := &TreasuryPage{
+				Load:             &load.Load{AppInfo: new(load.AppInfo), Theme: th},
+				GenericPageModal: app.NewGenericPageModal(TreasuryPageID),
+				searchEditor:     searchEditor,
+				PiKey:            "03f6e7",
+			}
+			pg.searchEditor.Editor.SetText(test.query)
+			pg.displayPolicies(displayed)
+			release := make(chan struct{})
+			pg.loadPolicies = func() ([]*dcr.TreasuryKeyPolicy, error) {
+				<-release
+				if test.err != nil {
+					return nil, test.err
+				}
+				return fetched, nil
+			}
+			pg.OnAttachedToNavigator(app.NewSimpleWindowNavigator(func() {
+				reloaded <- struct{}{}
+			}))
+
+			pg.FetchPolicies()
+			<-reloaded // Reload when the fetch starts.
+			if !pg.isPolicyFetchInProgress {
+				t.Fatal("expected a fetch to be in progress")
+			}
+
+			close(release)
+			select {
+			case <-reloaded:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for the fetch to complete")
+			}
+
+			if pg.isPolicyFetchInProgress {
+				t.Fatal("expected no fetch in progress after the fetch completed")
+			}
+			if got := policyKeys(t, pg.treasuryItems); !reflect.DeepEqual(got, test.wantItems) {
+				t.Fatalf("expected policies %v, got %v", test.wantItems, got)
+			}
+			if got := policyKeys(t, pg.filteredItems); !reflect.DeepEqual(got, test.wantFiltered) {
+				t.Fatalf("expected displayed policies %v, got %v", test.wantFiltered, got)
+			}
+		})
+	}
+}
+
+func TestTreasuryPolicyCache(t *testing.T) {
+	cache := newTreasuryPolicyCache()
+	cache.set(libutils.Mainnet, 1, []*dcr.TreasuryKeyPolicy{{PiKey: "03f6e7", Policy: "yes"}})
+
+	if _, ok := cache.get(libutils.Mainnet, 2); ok {
+		t.Fatal("expected no policies for a wallet that wasn't cached")
+	}
+
+	policies, ok := cache.get(libutils.Mainnet, 1)
+	if !ok || len(policies) != 1 || policies[0].Policy != "yes" {
+		t.Fatalf("expected the cached policy, got %d policies", len(policies))
+	}
+
+	cache.set(libutils.Testnet, 2, nil)
+	if _, ok := cache.get(libutils.Mainnet, 1); ok {
+		t.Fatal("expected the cache to be cleared when the net type changed")
+	}
+	if _, ok := cache.get(libutils.Testnet, 2); !ok {
+		t.Fatal("expected the policies of the new net type to be cached")
+	}
+}
+
+func TestGovernanceKeysURL(t *testing.T) {
+	tests := []struct {
+		net  libutils.NetworkType
+		want string
+	}{
+		{net: libutils.Mainnet, want: chaincfgURL + "/mainnetparams.go"},
+		{net: libutils.Testnet, want: chaincfgURL + "/testnetparams.go"},
+		{net: libutils.Simulation, want: chaincfgURL + "/simnetparams.go"},
+		{net: libutils.Regression, want: chaincfgURL + "/regnetparams.go"},
+		{net: libutils.Unknown, want: chaincfgURL},
+	}
+
+	for _, test := range tests {
+		if got := governanceKeysURL(test.net); got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.net, test.want, got)
+		}
+	}
+}
+
+func TestTreasuryPiKey(t *testing.T) {
+	if _, err := treasuryPiKey(nil); err == nil {
+		t.Fatal("expected an error without Pi keys")
+	}
+	if _, err := treasuryPiKey([][]byte{{}}); err == nil {
+		t.Fatal("expected an error for an empty Pi key")
+	}
+
+	piKey, err := treasuryPiKey([][]byte{{0x03, 0xf6}, {0x03, 0x19}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if piKey != "03f6" {
+		t.Fatalf("expected the first Pi key, got %q", piKey)
+	}
+}
@@ -0,0 +1,52 @@
+package governance
+
+import (
+	"sync"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+// policyCache holds the treasury policies last fetched for each wallet so
+// that reopening the treasury page displays them while they are refreshed.
+var policyCache = newTreasuryPolicyCache()
+
+// treasuryPolicyCache stores the treasury policies of the wallets of a single
+// network. Storing the policies of a wallet on another network clears the
+// policies of the previous network. Only the policies are stored, the page
+// builds new list items from them each time it displays them.
+type treasuryPolicyCache struct {
+	mu       sync.Mutex
+	netType  libutils.NetworkType
+	policies map[int][]*dcr.TreasuryKeyPolicy
+}
+
+func newTreasuryPolicyCache() *treasuryPolicyCache {
+	return &treasuryPolicyCache{
+		policies: make(map[int][]*dcr.TreasuryKeyPolicy),
+	}
+}
+
+// get returns the cached policies of the wallet.
+func (c *treasuryPolicyCache) get(netType libutils.NetworkType, walletID int) ([]*dcr.TreasuryKeyPolicy, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if netType != c.netType {
+		return nil, false
+	}
+	policies, ok := c.policies[walletID]
+	return policies, ok
+}
+
+// set caches the policies of the wallet.
+func (c *treasuryPolicyCache) set(netType libutils.NetworkType, walletID int, policies []*dcr.TreasuryKeyPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if netType != c.netType {
+		c.netType = netType
+		c.policies = make(map[int][]*dcr.TreasuryKeyPolicy)
+	}
+	c.policies[walletID] = policies
+}
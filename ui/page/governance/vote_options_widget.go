@@ -50,7 +50,7 @@ func newInputVoteOptions(l *load.Load, label string) *inputVoteOptionsWidgets {
 func (i *inputVoteOptionsWidgets) voteCount() int {
 	value, err := strconv.Atoi(i.input.Editor.Text())
 	if err != nil {
-		fmt.Println(err)
+		log.Debugf("invalid vote count: %v", err)
 		return 0
 	}
 	return value
@@ -2,7 +2,6 @@ package governance
 
 import (
 	"errors"
-	"fmt"
 
 	"gioui.org/font"
 	"gioui.org/layout"
@@ -95,7 +94,7 @@ func (as *WalletSelector) SelectFirstValidWallet() error {
 func (as *WalletSelector) setupSelectedWallet(wallet sharedW.Asset) {
 	_, walletTotalBalance, err := sharedW.Balances(wallet)
 	if err != nil {
-		fmt.Println(err)
+		log.Errorf("error fetching wallet balance: %v", err)
 		return
 	}
 
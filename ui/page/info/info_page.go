@@ -332,6 +332,7 @@ func (pg *WalletInfo) loadTransactions() {
 		log.Errorf("error loading transactions: %v", err)
 		return
 	}
+	sharedW.ApplyTxLabels(txs, pg.wallet.TxLabelsMap())
 	pg.transactions = txs
 	pg.showMaterialLoader = false
 	pg.ParentWindow().Reload()
@@ -345,6 +346,7 @@ func (pg *WalletInfo) loadStakes() {
 		log.Errorf("error loading staking activities: %v", err)
 		return
 	}
+	sharedW.ApplyTxLabels(txs, pg.wallet.TxLabelsMap())
 	for _, stakeTx := range txs {
 		if (stakeTx.Type == dcr.TxTypeTicketPurchase) || (stakeTx.Type == dcr.TxTypeRevocation) {
 			pg.stakes = append(pg.stakes, stakeTx)
@@ -15,6 +15,7 @@ import (
 	"gioui.org/widget"
 
 	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -45,6 +46,7 @@ type Page struct {
 	scrollContainer *widget.List
 	isNewAddr       bool
 	currentAddress  string
+	addressBalance  string
 	qrImage         *image.Image
 	newAddr, copy   *cryptomaterial.Clickable
 	info            cryptomaterial.IconButton
@@ -128,6 +130,7 @@ func (pg *Page) initWalletSelectors(wallet sharedW.Asset) {
 			}
 
 			pg.generateQRForAddress()
+			pg.updateAddressBalance()
 		}).
 		AccountValidator(func(account *sharedW.Account) bool {
 			if account.Number == load.MaxInt32 {
@@ -159,6 +162,7 @@ func (pg *Page) OnResume() {
 // the page is displayed.
 // Part of the load.Page interface.
 func (pg *Page) OnNavigatedTo() {
+	pg.accountDropdown.EnableAccountCreation(pg.ParentWindow())
 	if pg.selectedWallet == nil || !pg.selectedWallet.IsSynced() {
 		// Events are disabled until the wallet is fully synced.
 		return
@@ -178,9 +182,27 @@ func (pg *Page) OnNavigatedTo() {
 	} else {
 		pg.currentAddress = currentAddress
 		pg.generateQRForAddress()
+		pg.updateAddressBalance()
 	}
 }
 
+// updateAddressBalance refreshes the balance shown for the current address.
+// Address level balances are only displayed for BTC wallets in advanced mode.
+func (pg *Page) updateAddressBalance() {
+	pg.addressBalance = ""
+	btcAsset, ok := pg.selectedWallet.(*btc.Asset)
+	if !ok || !pg.IsAdvancedModeOn() || pg.currentAddress == "" {
+		return
+	}
+
+	balance, err := btcAsset.AddressBalance(pg.currentAddress)
+	if err != nil {
+		log.Errorf("Error getting address balance: %v", err)
+		return
+	}
+	pg.addressBalance = values.StringF(values.StrAddressBalance, balance.Confirmed, balance.Unconfirmed)
+}
+
 func (pg *Page) generateQRForAddress() {
 	qrCode, err := qrcode.New(pg.currentAddress, qrcode.WithLogoImage(pg.getSelectedWalletLogo()))
 	if err != nil {
@@ -322,6 +344,14 @@ func (pg *Page) contentLayout(gtx C) D {
 								}),
 								layout.Rigid(layout.Spacer{Height: values.MarginPadding24}.Layout),
 								layout.Rigid(pg.addressLayout),
+								layout.Rigid(func(gtx C) D {
+									if pg.addressBalance == "" {
+										return D{}
+									}
+									lbl := pg.Theme.Body2(pg.addressBalance)
+									lbl.Color = pg.Theme.Color.GrayText2
+									return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, lbl.Layout)
+								}),
 								layout.Rigid(layout.Spacer{Height: values.MarginPadding16}.Layout),
 								layout.Rigid(pg.copyAndNewAddressLayout),
 							)
@@ -462,6 +492,7 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 
 		pg.currentAddress = newAddr
 		pg.generateQRForAddress()
+		pg.updateAddressBalance()
 		pg.isNewAddr = false
 	}
 
@@ -1340,6 +1340,7 @@ func (pg *OverviewPage) loadTransactions() {
 			log.Errorf("error loading transactions: %v", err)
 			return
 		}
+		sharedW.ApplyTxLabels(txs, w.TxLabelsMap())
 
 		for _, tx := range txs {
 			transactions = append(transactions, &multiWalletTx{tx, w.GetWalletID()})
@@ -1367,6 +1368,7 @@ func (pg *OverviewPage) loadStakes() {
 			log.Errorf("error loading staking activities: %v", err)
 			return
 		}
+		sharedW.ApplyTxLabels(txs, w.TxLabelsMap())
 		for _, stakeTx := range txs {
 			if (stakeTx.Type == dcr.TxTypeTicketPurchase) || (stakeTx.Type == dcr.TxTypeRevocation) {
 				stakes = append(stakes, &multiWalletTx{stakeTx, w.GetWalletID()})
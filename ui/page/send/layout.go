@@ -43,6 +43,7 @@ func (pg *Page) initLayoutWidgets() {
 	pg.closeButton.Inset = layout.Inset{Top: values.MarginPadding12, Bottom: values.MarginPadding12}
 
 	pg.toCoinSelection = pg.Theme.NewClickable(false)
+	pg.importSignedTx = pg.Theme.NewClickable(false)
 }
 
 // Layout draws the page UI components into the provided layout context
@@ -237,6 +238,9 @@ func (pg *Page) advanceOptionsLayout(gtx C) D {
 	if pg.hideAdvancedOptions { // Hide advanced options on the send modal to create more space
 		return D{}
 	}
+	if !pg.IsAdvancedModeOn() { // Manual fee rates and coin control are power features.
+		return D{}
+	}
 	marginMinus32 := values.MarginPadding0
 	if pg.modalLayout != nil {
 		marginMinus32 = values.MarginPaddingMinus32
@@ -362,6 +366,20 @@ func (pg *Page) balanceSection(gtx C) D {
 				}))
 				return layout.Flex{}.Layout(gtx, flexChilds...)
 			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.selectedWallet == nil || pg.selectedWallet.CanSign() {
+					return D{}
+				}
+				return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, func(gtx C) D {
+					return layout.Center.Layout(gtx, func(gtx C) D {
+						return pg.importSignedTx.Layout(gtx, func(gtx C) D {
+							lbl := pg.Theme.Label(values.TextSize16, values.String(values.StrImportSignedTx))
+							lbl.Color = pg.Theme.Color.Primary
+							return lbl.Layout(gtx)
+						})
+					})
+				})
+			}),
 		)
 	})
 }
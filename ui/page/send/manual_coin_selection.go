@@ -6,7 +6,9 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
+	"decred.org/dcrwallet/v4/wallet/txrules"
 	"gioui.org/font"
 	"gioui.org/io/clipboard"
 	"gioui.org/layout"
@@ -16,6 +18,7 @@ import (
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/modal"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
@@ -32,6 +35,11 @@ type UTXOInfo struct {
 	*sharedW.UnspentOutput
 	checkbox    cryptomaterial.CheckBoxStyle
 	addressCopy *cryptomaterial.Clickable
+	// addressLabel is shown in place of the address if set.
+	addressLabel string
+	labelEdit    *cryptomaterial.Clickable
+	// dust is true if the UTXO costs more in fees to spend than it's worth.
+	dust bool
 }
 
 type AccountUTXOInfo struct {
@@ -57,12 +65,35 @@ type ManualCoinSelectionPage struct {
 	actionButton cryptomaterial.Button
 	clearButton  cryptomaterial.Button
 
+	// autoSelectButton selects enough UTXOs to fund the send amount using the
+	// strategy chosen in autoSelectDropdown.
+	autoSelectButton   cryptomaterial.Button
+	autoSelectDropdown *cryptomaterial.DropDown
+	// hideDust hides the UTXOs that cost more to spend than they're worth.
+	hideDust cryptomaterial.CheckBoxStyle
+
 	selectedUTXOs cryptomaterial.Label
 	txSize        cryptomaterial.Label
 	totalAmount   cryptomaterial.Label
-
-	selectedUTXOrows []*sharedW.UnspentOutput
-	selectedAmount   float64
+	// feePreview shows the change left by the selected UTXOs or how short
+	// they fall of the amount to send plus the fee.
+	feePreview cryptomaterial.Label
+	// selectionCovered is true if the selected UTXOs cover the target amount
+	// plus the fee.
+	selectionCovered bool
+	coveredIcon      *cryptomaterial.Icon
+
+	// targetAmount is the amount to send, the selected UTXOs must cover it
+	// plus the fee at targetFeeRate. It is zero if the amount isn't known,
+	// e.g. if the maximum amount is sent.
+	targetAmount  int64
+	targetFeeRate int64
+
+	// selectedRows holds the checked UTXOs keyed by their outpoint.
+	selectedRows map[string]*sharedW.UnspentOutput
+	// preselectedUTXOs are checked when the UTXO list is loaded.
+	preselectedUTXOs     []*sharedW.UnspentOutput
+	selectedUTXOCallback func([]*sharedW.UnspentOutput)
 
 	amountLabel        labelCell
 	addressLabel       labelCell
@@ -105,6 +136,18 @@ type Lastclicked struct {
 	count   int
 }
 
+// UTXO sort keys, the positions of the sortable columns in pg.clickables.
+const (
+	sortByAmount = iota
+	sortByAddress
+	sortByConfirmations
+	sortByDate
+)
+
+// defaultSortEvent lists the UTXOs with the largest amounts first, an even
+// count sorts in descending order.
+var defaultSortEvent = Lastclicked{clicked: sortByAmount, count: 2}
+
 type labelCell struct {
 	clickable *cryptomaterial.Clickable
 	label     cryptomaterial.Label
@@ -112,9 +155,10 @@ type labelCell struct {
 
 func NewManualCoinSelectionPage(l *load.Load, sendPage *Page) *ManualCoinSelectionPage {
 	pg := &ManualCoinSelectionPage{
-		Load:         l,
-		actionButton: l.Theme.Button(values.String(values.StrDone)),
-		clearButton:  l.Theme.OutlineButton("— " + values.String(values.StrClearSelection)),
+		Load:             l,
+		actionButton:     l.Theme.Button(values.String(values.StrDone)),
+		clearButton:      l.Theme.OutlineButton("— " + values.String(values.StrClearSelection)),
+		autoSelectButton: l.Theme.OutlineButton(values.String(values.StrAutoSelect)),
 		listContainer: &widget.List{
 			List: layout.List{Axis: layout.Vertical},
 		},
@@ -140,9 +184,29 @@ func NewManualCoinSelectionPage(l *load.Load, sendPage *Page) *ManualCoinSelecti
 	pg.clearButton.HighlightColor = cryptomaterial.GenHighlightColor(l.Theme.Color.Danger)
 	pg.clearButton.TextSize = values.TextSizeTransform(l.IsMobileView(), values.TextSize16)
 
+	pg.autoSelectButton.Font.Weight = font.SemiBold
+	pg.autoSelectButton.Inset = layout.UniformInset(values.MarginPadding4)
+	pg.autoSelectButton.TextSize = values.TextSizeTransform(l.IsMobileView(), values.TextSize16)
+
+	autoSelectItems := []cryptomaterial.DropDownItem{
+		{Text: values.String(values.StrFewestCoins)},
+		{Text: values.String(values.StrConsolidateCoins)},
+	}
+	pg.autoSelectDropdown = l.Theme.DropdownWithCustomPos(autoSelectItems, values.CoinSelectionDropdownGroup, 0, 0, true)
+	pg.autoSelectDropdown.Width = values.MarginPadding180
+	pg.autoSelectDropdown.Hoverable = false
+	pg.autoSelectDropdown.SelectedItemIconColor = &l.Theme.Color.Primary
+	pg.autoSelectDropdown.SetConvertTextSize(l.ConvertTextSize)
+
+	pg.hideDust = l.Theme.CheckBox(new(widget.Bool), values.String(values.StrHideDust))
+	pg.hideDust.TextSize = values.TextSizeTransform(l.IsMobileView(), values.TextSize14)
+
 	pg.txSize = pg.Theme.Label(values.TextSize14, "--")
 	pg.totalAmount = pg.Theme.Label(values.TextSize14, "--")
 	pg.selectedUTXOs = pg.Theme.Label(values.TextSize14, "--")
+	pg.feePreview = pg.Theme.Label(values.TextSize14, "")
+	pg.coveredIcon = cryptomaterial.NewIcon(l.Theme.Icons.NavigationCheck)
+	pg.coveredIcon.Color = l.Theme.Color.Success
 
 	pg.txSize.Font.Weight = font.SemiBold
 	pg.totalAmount.Font.Weight = font.SemiBold
@@ -153,7 +217,7 @@ func NewManualCoinSelectionPage(l *load.Load, sendPage *Page) *ManualCoinSelecti
 	pg.amountClickable = pg.Theme.NewClickable(true)
 	pg.addressClickable = pg.Theme.NewClickable(true)
 	pg.confirmationsClickable = pg.Theme.NewClickable(true)
-	pg.dateClickable = pg.Theme.NewClickable(false)
+	pg.dateClickable = pg.Theme.NewClickable(true)
 
 	pg.strAssetType = sendPage.selectedWallet.GetAssetType().String()
 	name := fmt.Sprintf("%v(%v)", values.String(values.StrAmount), pg.strAssetType)
@@ -188,19 +252,39 @@ func NewManualCoinSelectionPage(l *load.Load, sendPage *Page) *ManualCoinSelecti
 		pg.dateClickable,          // Component 5
 	}
 
+	pg.lastSortEvent = defaultSortEvent
 	pg.initializeFields()
 
 	return pg
 }
 
-func (pg *ManualCoinSelectionPage) initializeFields() {
-	pg.lastSortEvent = Lastclicked{clicked: -1}
-	pg.selectedUTXOrows = make([]*sharedW.UnspentOutput, 0)
-	pg.selectedAmount = 0
+// SetSelectedUTXOCallback sets the function the selected UTXOs are passed to
+// when the user is done selecting them.
+func (pg *ManualCoinSelectionPage) SetSelectedUTXOCallback(callback func([]*sharedW.UnspentOutput)) *ManualCoinSelectionPage {
+	pg.selectedUTXOCallback = callback
+	return pg
+}
 
-	pg.selectedUTXOs.Text = "0"
-	pg.txSize.Text = pg.computeUTXOsSize()
-	pg.totalAmount.Text = "0 " + pg.strAssetType
+// SetTargetAmount sets the amount to send and the fee rate per kB the tx will
+// be created with. The Done button is disabled until the selected UTXOs cover
+// the amount plus the fee.
+func (pg *ManualCoinSelectionPage) SetTargetAmount(amount, feeRate int64) *ManualCoinSelectionPage {
+	pg.targetAmount = amount
+	pg.targetFeeRate = feeRate
+	return pg
+}
+
+// PreselectUTXOs checks the provided UTXOs when the page is displayed so a
+// previous choice of coins can be edited. UTXOs that have been spent since are
+// ignored.
+func (pg *ManualCoinSelectionPage) PreselectUTXOs(utxos []*sharedW.UnspentOutput) *ManualCoinSelectionPage {
+	pg.preselectedUTXOs = utxos
+	return pg
+}
+
+func (pg *ManualCoinSelectionPage) initializeFields() {
+	pg.selectedRows = make(map[string]*sharedW.UnspentOutput)
+	pg.updateSummaryInfo()
 }
 
 // OnNavigatedTo is called when the page is about to be displayed and
@@ -222,33 +306,47 @@ func (pg *ManualCoinSelectionPage) OnNavigatedTo() {
 
 func (pg *ManualCoinSelectionPage) fetchAccountsInfo() error {
 	account := pg.sendPage.accountDropdown.SelectedAccount()
+	if account == nil {
+		return fmt.Errorf("no source account selected")
+	}
 	info, err := pg.sendPage.selectedWallet.UnspentOutputs(int32(account.AccountNumber))
 	if err != nil {
 		return fmt.Errorf("querying the account (%v) info failed: %v", account.AccountNumber, err)
 	}
 
-	previousUTXOs := make(map[string]struct{}, 0)
-	// Use the previous Selection of UTXO if same acccount source has been used.
-	if account == pg.sendPage.selectedUTXOs.sourceAccount {
-		for _, utxo := range pg.sendPage.selectedUTXOs.selectedUTXOs {
-			previousUTXOs[utxo.TxID] = struct{}{}
-			pg.selectedAmount += utxo.Amount.ToCoin()
-		}
-		pg.selectedUTXOrows = pg.sendPage.selectedUTXOs.selectedUTXOs
+	// Keep the selection made on this page if the list is being reloaded,
+	// otherwise start from the UTXOs preselected by the caller.
+	previousSelection := pg.selectedUTXOList()
+	firstLoad := pg.accountUTXOs.Details == nil
+	if firstLoad {
+		previousSelection = pg.preselectedUTXOs
+	}
+	previousUTXOs := make(map[string]struct{}, len(previousSelection))
+	for _, utxo := range previousSelection {
+		previousUTXOs[utxoOutpoint(utxo)] = struct{}{}
 	}
 
+	pg.selectedRows = make(map[string]*sharedW.UnspentOutput, len(previousUTXOs))
 	rowInfo := make([]*UTXOInfo, len(info))
+	addressLabels := pg.sendPage.selectedWallet.AddressLabels()
+	dustThreshold := pg.dustThreshold(info)
 	// create checkboxes and address copy components for all the utxos available.
 	for i, row := range info {
 		info := &UTXOInfo{
 			UnspentOutput: row,
 			checkbox:      pg.Theme.CheckBox(new(widget.Bool), ""),
 			addressCopy:   pg.Theme.NewClickable(false),
+			addressLabel:  addressLabels[row.Address],
+			labelEdit:     pg.Theme.NewClickable(false),
+			dust:          row.Amount.ToInt() <= dustThreshold,
 		}
 
 		info.checkbox.CheckBoxStyle.Size = 20
-		// Check if TxID match. If true, set checked to true.
-		_, info.checkbox.CheckBox.Value = previousUTXOs[info.TxID]
+		// Keep the UTXO checked if it was selected and hasn't been spent since.
+		if _, ok := previousUTXOs[utxoOutpoint(row)]; ok {
+			info.checkbox.CheckBox.Value = true
+			pg.selectedRows[utxoOutpoint(row)] = row
+		}
 
 		rowInfo[i] = info
 	}
@@ -258,7 +356,13 @@ func (pg *ManualCoinSelectionPage) fetchAccountsInfo() error {
 		Account: account.Name,
 	}
 
-	pg.accountCollapsible.SetExpanded(len(pg.selectedUTXOrows) > 0)
+	pg.sortUTXOs()
+
+	// Leave the group expanded or collapsed as the user left it when the list
+	// is reloaded.
+	if firstLoad {
+		pg.accountCollapsible.SetExpanded(len(pg.selectedRows) > 0)
+	}
 	pg.updateSummaryInfo()
 
 	return nil
@@ -271,7 +375,9 @@ func (pg *ManualCoinSelectionPage) fetchAccountsInfo() error {
 // Part of the load.Page interface.
 func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 	if pg.actionButton.Clicked(gtx) {
-		pg.sendPage.UpdateSelectedUTXOs(pg.selectedUTXOrows)
+		if pg.selectedUTXOCallback != nil {
+			pg.selectedUTXOCallback(pg.selectedUTXOList())
+		}
 		if pg.modalLayout != nil {
 			pg.modalLayout.Dismiss()
 		} else {
@@ -294,6 +400,10 @@ func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 		pg.initializeFields()
 	}
 
+	if pg.autoSelectButton.Clicked(gtx) {
+		pg.autoSelectUTXOs()
+	}
+
 	if pg.accountCollapsible.IsExpanded() {
 		for pos, component := range pg.clickables {
 			if component == nil || !component.Clicked(gtx) {
@@ -311,33 +421,40 @@ func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 				pg.lastSortEvent.count = 0
 			}
 			pg.lastSortEvent.count++
-
-			isAscendingOrder := pg.lastSortEvent.count%2 == 0
-			sort.SliceStable(pg.accountUTXOs.Details, func(i, j int) bool {
-				return sortUTXOrows(i, j, pos, isAscendingOrder, pg.accountUTXOs.Details)
-			})
+			pg.sortUTXOs()
 
 			pg.sortingInProgress = false
 			break
 		}
 	}
 
+	for _, record := range pg.accountUTXOs.Details {
+		if record.labelEdit.Clicked(gtx) {
+			pg.showAddressLabelModal(record.Address, record.addressLabel)
+			break
+		}
+	}
+
+	if pg.hideDust.CheckBox.Update(gtx) && pg.hideDust.CheckBox.Value {
+		// Hidden UTXOs can't stay selected.
+		for _, record := range pg.accountUTXOs.Details {
+			if record.dust {
+				record.checkbox.CheckBox.Value = false
+				delete(pg.selectedRows, utxoOutpoint(record.UnspentOutput))
+			}
+		}
+		pg.updateSummaryInfo()
+	}
+
 	// Update Summary information as the last section when handling events.
 	for i := 0; i < len(pg.accountUTXOs.Details); i++ {
 		record := pg.accountUTXOs.Details[i]
 		if record.checkbox.CheckBox.Update(gtx) {
+			outpoint := utxoOutpoint(record.UnspentOutput)
 			if record.checkbox.CheckBox.Value {
-				pg.selectedUTXOrows = append(pg.selectedUTXOrows, record.UnspentOutput)
-				pg.selectedAmount += record.Amount.ToCoin()
+				pg.selectedRows[outpoint] = record.UnspentOutput
 			} else {
-				for index, item := range pg.selectedUTXOrows {
-					if item.TxID == record.TxID {
-						copy(pg.selectedUTXOrows[index:], pg.selectedUTXOrows[index+1:])
-						pg.selectedUTXOrows = pg.selectedUTXOrows[:len(pg.selectedUTXOrows)-1]
-						break
-					}
-				}
-				pg.selectedAmount -= record.Amount.ToCoin()
+				delete(pg.selectedRows, outpoint)
 			}
 
 			pg.updateSummaryInfo()
@@ -345,10 +462,211 @@ func (pg *ManualCoinSelectionPage) HandleUserInteractions(gtx C) {
 	}
 }
 
+// dustThreshold returns the fee of spending one of utxos at the target fee
+// rate. UTXOs worth no more than it cost more to spend than they add to a tx.
+// The size of an input is estimated from the size a UTXO adds to a tx.
+func (pg *ManualCoinSelectionPage) dustThreshold(utxos []*sharedW.UnspentOutput) int64 {
+	if len(utxos) == 0 {
+		return 0
+	}
+
+	wallet := pg.sendPage.selectedWallet
+	destination := pg.sendPage.recipients[0].destinationAddress()
+	oneInputSize, err := wallet.ComputeTxSizeEstimation(destination, utxos[:1])
+	if err != nil {
+		log.Error(err)
+		return 0
+	}
+	twoInputsSize, err := wallet.ComputeTxSizeEstimation(destination, []*sharedW.UnspentOutput{utxos[0], utxos[0]})
+	if err != nil {
+		log.Error(err)
+		return 0
+	}
+
+	feeRate := pg.targetFeeRate
+	if feeRate <= 0 {
+		feeRate = feeRatePerkB(wallet)
+	}
+	return feeRate * int64(twoInputsSize-oneInputSize) / 1000
+}
+
+// visibleUTXOs returns the UTXO rows to list, dust is left out if hidden.
+func (pg *ManualCoinSelectionPage) visibleUTXOs() []*UTXOInfo {
+	if !pg.hideDust.CheckBox.Value {
+		return pg.accountUTXOs.Details
+	}
+	utxos := make([]*UTXOInfo, 0, len(pg.accountUTXOs.Details))
+	for _, record := range pg.accountUTXOs.Details {
+		if !record.dust {
+			utxos = append(utxos, record)
+		}
+	}
+	return utxos
+}
+
+// sortUTXOs orders the UTXO rows by the last sort column chosen. Selected rows
+// are keyed by outpoint so the selection isn't affected by the order.
+func (pg *ManualCoinSelectionPage) sortUTXOs() {
+	pos := pg.lastSortEvent.clicked
+	if pos < 0 {
+		return
+	}
+
+	isAscendingOrder := pg.lastSortEvent.count%2 == 0
+	sort.SliceStable(pg.accountUTXOs.Details, func(i, j int) bool {
+		return sortUTXOrows(i, j, pos, isAscendingOrder, pg.accountUTXOs.Details)
+	})
+}
+
+// showAddressLabelModal lets the user change or, by saving an empty label,
+// remove the label of address.
+func (pg *ManualCoinSelectionPage) showAddressLabelModal(address, label string) {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrName)).
+		SetText(label).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(newLabel string, tm *modal.TextInputModal) bool {
+			newLabel = strings.TrimSpace(newLabel)
+			if utf8.RuneCountInString(newLabel) > sharedW.MaxAddressLabelLength {
+				tm.SetError(values.StringF(values.StrTxLabelLengthError, sharedW.MaxAddressLabelLength))
+				return false
+			}
+
+			if err := pg.sendPage.selectedWallet.SetAddressLabel(address, newLabel); err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+
+			// The label applies to every UTXO paying to the address.
+			for _, record := range pg.accountUTXOs.Details {
+				if record.Address == address {
+					record.addressLabel = newLabel
+				}
+			}
+			return true
+		})
+	textModal.Title(values.String(values.StrEditAddressLabel)).
+		SetPositiveButtonText(values.String(values.StrSave))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+// selectedUTXOList returns the selected UTXOs in the order they are listed.
+func (pg *ManualCoinSelectionPage) selectedUTXOList() []*sharedW.UnspentOutput {
+	utxos := make([]*sharedW.UnspentOutput, 0, len(pg.selectedRows))
+	for _, record := range pg.accountUTXOs.Details {
+		if utxo, ok := pg.selectedRows[utxoOutpoint(record.UnspentOutput)]; ok {
+			utxos = append(utxos, utxo)
+		}
+	}
+	return utxos
+}
+
+// updateSummaryInfo recomputes the summary labels from the selected UTXOs.
 func (pg *ManualCoinSelectionPage) updateSummaryInfo() {
+	var total int64
+	for _, utxo := range pg.selectedRows {
+		total += utxo.Amount.ToInt()
+	}
+
 	pg.txSize.Text = pg.computeUTXOsSize()
-	pg.selectedUTXOs.Text = fmt.Sprintf("%d", len(pg.selectedUTXOrows))
-	pg.totalAmount.Text = fmt.Sprintf("%f %s", pg.selectedAmount, pg.strAssetType)
+	pg.selectedUTXOs.Text = fmt.Sprintf("%d", len(pg.selectedRows))
+	pg.totalAmount.Text = pg.sendPage.selectedWallet.ToAmount(total).String()
+	pg.updateFeePreview()
+}
+
+// updateFeePreview checks the selected UTXOs against the amount to send and
+// the fee at the wallet's fee rate. Nothing is shown if no UTXO is selected or
+// if the maximum amount is sent as it is then funded by the selection.
+func (pg *ManualCoinSelectionPage) updateFeePreview() {
+	pg.feePreview.Text = ""
+	pg.selectionCovered = false
+	// No selection leaves the choice of UTXOs to the wallet.
+	pg.actionButton.SetEnabled(true)
+	if len(pg.selectedRows) == 0 || pg.targetAmount <= 0 {
+		return
+	}
+
+	wallet := pg.sendPage.selectedWallet
+	change, sufficient := pg.selectionCoverage(pg.targetAmount, pg.targetFeeRate)
+	pg.actionButton.SetEnabled(sufficient)
+	if !sufficient {
+		pg.feePreview.Text = values.StringF(values.StrSelectionShort, wallet.ToAmount(-change).String())
+		pg.feePreview.Color = pg.Theme.Color.Danger
+		return
+	}
+	pg.selectionCovered = true
+	pg.feePreview.Text = values.StringF(values.StrSelectionChange, wallet.ToAmount(change).String())
+	pg.feePreview.Color = pg.Theme.Color.Success
+}
+
+// autoSelectUTXOs replaces the selection with the UTXOs needed to fund the send
+// amount and the fee. The largest UTXOs are picked first to spend as few UTXOs
+// as possible, or the smallest first to consolidate as many as possible.
+func (pg *ManualCoinSelectionPage) autoSelectUTXOs() {
+	if pg.targetAmount <= 0 {
+		pg.Toast.NotifyError(values.String(values.StrEnterAmountToAutoSelect))
+		return
+	}
+
+	smallestFirst := pg.autoSelectDropdown.SelectedIndex() == 1
+	visible := pg.visibleUTXOs()
+	candidates := make([]*UTXOInfo, len(visible))
+	copy(candidates, visible)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if smallestFirst {
+			return candidates[i].Amount.ToInt() < candidates[j].Amount.ToInt()
+		}
+		return candidates[i].Amount.ToInt() > candidates[j].Amount.ToInt()
+	})
+
+	pg.selectedRows = make(map[string]*sharedW.UnspentOutput)
+	for _, record := range candidates {
+		if len(pg.selectedRows) > 0 {
+			if _, sufficient := pg.selectionCoverage(pg.targetAmount, pg.targetFeeRate); sufficient {
+				break
+			}
+		}
+		pg.selectedRows[utxoOutpoint(record.UnspentOutput)] = record.UnspentOutput
+	}
+
+	for _, record := range pg.accountUTXOs.Details {
+		_, selected := pg.selectedRows[utxoOutpoint(record.UnspentOutput)]
+		record.checkbox.CheckBox.Value = selected
+	}
+	pg.accountCollapsible.SetExpanded(true)
+	pg.updateSummaryInfo()
+}
+
+// selectionCoverage returns whether the selected UTXOs cover sendAmount and
+// the fee of a tx spending them at feeRatePerkB. change holds the amount left
+// over, it is negative by the shortfall if the selection is insufficient.
+func (pg *ManualCoinSelectionPage) selectionCoverage(sendAmount, feeRatePerkB int64) (change int64, sufficient bool) {
+	var selectedAmount int64
+	for _, utxo := range pg.selectedRows {
+		selectedAmount += utxo.Amount.ToInt()
+	}
+
+	destination := pg.sendPage.recipients[0].destinationAddress()
+	size, err := pg.sendPage.selectedWallet.ComputeTxSizeEstimation(destination, pg.selectedUTXOList())
+	if err != nil {
+		log.Error(err)
+	}
+
+	fee := feeRatePerkB * int64(size) / 1000
+	change = selectedAmount - sendAmount - fee
+	return change, change >= 0
+}
+
+// feeRatePerkB returns the fee rate txs from wallet are created with. DCR
+// wallets don't support user set fee rates and use the default relay fee.
+func feeRatePerkB(wallet sharedW.Asset) int64 {
+	type userFeeRater interface {
+		GetUserFeeRate() sharedW.AssetAmount
+	}
+	if wallet, ok := wallet.(userFeeRater); ok {
+		return wallet.GetUserFeeRate().ToInt()
+	}
+	return int64(txrules.DefaultRelayFeePerKb)
 }
 
 func (pg *ManualCoinSelectionPage) computeUTXOsSize() string {
@@ -356,7 +674,7 @@ func (pg *ManualCoinSelectionPage) computeUTXOsSize() string {
 
 	// Access to coin selection page is restricted unless destination address is selected.
 	destination := pg.sendPage.recipients[0].destinationAddress()
-	feeNSize, err := wallet.ComputeTxSizeEstimation(destination, pg.selectedUTXOrows)
+	feeNSize, err := wallet.ComputeTxSizeEstimation(destination, pg.selectedUTXOList())
 	if err != nil {
 		log.Error(err)
 	}
@@ -372,6 +690,10 @@ func (pg *ManualCoinSelectionPage) computeUTXOsSize() string {
 // Part of the load.Page interface.
 func (pg *ManualCoinSelectionPage) OnNavigatedFrom() {
 	pg.ctxCancel()
+
+	// Start afresh from the preselected UTXOs if the page is displayed again.
+	pg.selectedRows = make(map[string]*sharedW.UnspentOutput)
+	pg.accountUTXOs = AccountUTXOInfo{}
 }
 
 // Layout draws the page UI components into the provided layout context
@@ -476,6 +798,25 @@ func (pg *ManualCoinSelectionPage) summarySection(gtx C) D {
 							}),
 						)
 					}),
+					layout.Rigid(func(gtx C) D {
+						if pg.feePreview.Text == "" {
+							return D{}
+						}
+						pg.feePreview.TextSize = values.TextSizeTransform(pg.IsMobileView(), values.TextSize14)
+						return layout.Inset{Top: values.MarginPadding10}.Layout(gtx, func(gtx C) D {
+							return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+								layout.Rigid(func(gtx C) D {
+									if !pg.selectionCovered {
+										return D{}
+									}
+									return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+										return pg.coveredIcon.Layout(gtx, values.MarginPadding16)
+									})
+								}),
+								layout.Rigid(pg.feePreview.Layout),
+							)
+						})
+					}),
 				)
 			})
 		})
@@ -504,7 +845,17 @@ func (pg *ManualCoinSelectionPage) accountListSection(gtx C) D {
 					return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
 						layout.Rigid(textLabel.Layout),
 						layout.Flexed(1, func(gtx C) D {
-							return layout.E.Layout(gtx, pg.clearButton.Layout)
+							return layout.E.Layout(gtx, func(gtx C) D {
+								return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+									layout.Rigid(pg.hideDust.Layout),
+									layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+									layout.Rigid(pg.autoSelectDropdown.Layout),
+									layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+									layout.Rigid(pg.autoSelectButton.Layout),
+									layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+									layout.Rigid(pg.clearButton.Layout),
+								)
+							})
 						}),
 					)
 				}),
@@ -516,13 +867,14 @@ func (pg *ManualCoinSelectionPage) accountListSection(gtx C) D {
 					}
 
 					collapsibleBody := func(gtx C) D {
-						if len(pg.accountUTXOs.Details) == 0 {
+						utxos := pg.visibleUTXOs()
+						if len(utxos) == 0 {
 							gtx.Constraints.Min.X = gtx.Constraints.Max.X
 							return layout.Center.Layout(gtx,
 								pg.Theme.Label(textSize14, values.String(values.StrNoUTXOs)).Layout,
 							)
 						}
-						return pg.accountListItemsSection(gtx, pg.accountUTXOs.Details)
+						return pg.accountListItemsSection(gtx, utxos)
 					}
 					return pg.accountCollapsible.Layout(gtx, collapsibleHeader, collapsibleBody)
 				}),
@@ -560,53 +912,114 @@ func (pg *ManualCoinSelectionPage) generateLabel(txt interface{}, clickable *cry
 	}
 }
 
+// accountListItemsSection draws the column titles above the UTXO rows. Only
+// the rows scroll so the titles stay in view.
 func (pg *ManualCoinSelectionPage) accountListItemsSection(gtx C, utxos []*UTXOInfo) D {
 	return layout.Inset{Right: values.MarginPadding2}.Layout(gtx, func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(pg.utxoColumnTitles),
 			layout.Rigid(func(gtx C) D {
-				return pg.rowItemsSection(gtx, nil, pg.amountLabel, nil, pg.addressLabel,
-					nil, pg.confirmationsLabel, nil, pg.dateLabel)
+				return pg.utxoRows(gtx, utxos)
 			}),
+		)
+	})
+}
+
+// utxoColumnTitles draws the sortable Amount, Address, Confirmations and Date
+// titles, aligned with the UTXO row columns.
+func (pg *ManualCoinSelectionPage) utxoColumnTitles(gtx C) D {
+	return pg.rowItemsSection(gtx, nil, pg.amountLabel, nil, pg.addressLabel,
+		nil, pg.confirmationsLabel, nil, pg.dateLabel)
+}
+
+// utxoRows draws the scrollable list of UTXO rows.
+func (pg *ManualCoinSelectionPage) utxoRows(gtx C, utxos []*UTXOInfo) D {
+	gtx.Constraints.Min.X = gtx.Constraints.Max.X
+	return pg.Theme.List(pg.listContainer).Layout(gtx, len(utxos), func(gtx C, index int) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(func(gtx C) D {
-				gtx.Constraints.Min.X = gtx.Constraints.Max.X
-				return pg.Theme.List(pg.listContainer).Layout(gtx, len(utxos), func(gtx C, index int) D {
-					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				v := utxos[index]
+				checkButton := &v.checkbox                                                            // Component 1
+				amountLabel := pg.generateLabel(v.Amount.ToCoin(), nil)                               // component 2
+				addresslabel := pg.generateLabel(v.Address, nil)                                      // Component 3
+				confirmationsLabel := pg.generateLabel(v.Confirmations, nil)                          // Component 4
+				dateLabel := pg.generateLabel(libutils.FormatUTCShortTime(v.ReceiveTime.Unix()), nil) // Component 5
+
+				// copy destination Address
+				if v.addressCopy.Clicked(gtx) {
+					gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(v.Address))})
+					pg.Toast.Notify(values.String(values.StrAddressCopied))
+				}
+
+				if v.addressLabel != "" {
+					addresslabel = pg.generateLabel(v.addressLabel, nil)
+					addresslabel.label.Color = pg.Theme.Color.Text
+				}
+
+				var amountComponent interface{} = amountLabel
+				if v.dust {
+					// Mute the row of a UTXO that isn't worth spending.
+					for _, cell := range []*labelCell{&amountLabel, &addresslabel, &confirmationsLabel, &dateLabel} {
+						cell.label.Color = pg.Theme.Color.GrayText3
+					}
+					amountComponent = func(gtx C) D {
+						return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+							layout.Rigid(pg.dustTag),
+							layout.Rigid(amountLabel.label.Layout),
+						)
+					}
+				}
+
+				addressComponent := func(gtx C) D {
+					return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
-							v := utxos[index]
-							checkButton := &v.checkbox                                                            // Component 1
-							amountLabel := pg.generateLabel(v.Amount.ToCoin(), nil)                               // component 2
-							addresslabel := pg.generateLabel(v.Address, nil)                                      // Component 3
-							confirmationsLabel := pg.generateLabel(v.Confirmations, nil)                          // Component 4
-							dateLabel := pg.generateLabel(libutils.FormatUTCShortTime(v.ReceiveTime.Unix()), nil) // Component 5
-
-							// copy destination Address
-							if v.addressCopy.Clicked(gtx) {
-								gtx.Execute(clipboard.WriteCmd{Data: io.NopCloser(strings.NewReader(v.Address))})
-								pg.Toast.Notify(values.String(values.StrAddressCopied))
-							}
-
-							addressComponent := func(gtx C) D {
-								return v.addressCopy.Layout(gtx, addresslabel.label.Layout)
-							}
-							return pg.rowItemsSection(gtx, checkButton, amountLabel, nil, addressComponent,
-								nil, confirmationsLabel, nil, dateLabel)
+							return v.addressCopy.Layout(gtx, addresslabel.label.Layout)
 						}),
 						layout.Rigid(func(gtx C) D {
-							// No divider for last row
-							if index == len(utxos)-1 {
-								return D{}
-							}
-							return layout.Inset{Bottom: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
-								return pg.Theme.Separator().Layout(gtx)
+							return layout.Inset{Left: values.MarginPadding4}.Layout(gtx, func(gtx C) D {
+								return v.labelEdit.Layout(gtx, pg.Theme.Icons.EditIcon.Layout12dp)
 							})
 						}),
 					)
+				}
+				return pg.rowItemsSection(gtx, checkButton, amountComponent, nil, addressComponent,
+					nil, confirmationsLabel, nil, dateLabel)
+			}),
+			layout.Rigid(func(gtx C) D {
+				// No divider for last row
+				if index == len(utxos)-1 {
+					return D{}
+				}
+				return layout.Inset{Bottom: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
+					return pg.Theme.Separator().Layout(gtx)
 				})
 			}),
 		)
 	})
 }
 
+// dustTag draws the tag marking a dust UTXO.
+func (pg *ManualCoinSelectionPage) dustTag(gtx C) D {
+	return cryptomaterial.LinearLayout{
+		Width:  cryptomaterial.WrapContent,
+		Height: cryptomaterial.WrapContent,
+		Border: cryptomaterial.Border{
+			Radius: cryptomaterial.Radius(4),
+			Color:  pg.Theme.Color.Gray2,
+			Width:  values.MarginPadding1,
+		},
+		Margin: layout.Inset{Right: values.MarginPadding4},
+		Padding: layout.Inset{
+			Left:  values.MarginPadding4,
+			Right: values.MarginPadding4,
+		},
+	}.Layout2(gtx, func(gtx C) D {
+		lbl := pg.Theme.Label(values.TextSize12, values.String(values.StrDust))
+		lbl.Color = pg.Theme.Color.GrayText3
+		return lbl.Layout(gtx)
+	})
+}
+
 func (pg *ManualCoinSelectionPage) rowItemsSection(gtx C, components ...interface{}) D {
 	getRowItem := func(index int) layout.Widget {
 		var widget layout.Widget
@@ -669,14 +1082,20 @@ func (pg *ManualCoinSelectionPage) rowItemsSection(gtx C, components ...interfac
 	})
 }
 
+// utxoOutpoint returns the outpoint identifying utxo, outputs of the same
+// transaction share the TxID.
+func utxoOutpoint(utxo *sharedW.UnspentOutput) string {
+	return fmt.Sprintf("%s:%d", utxo.TxID, utxo.Vout)
+}
+
 func sortUTXOrows(i, j, pos int, ascendingOrder bool, elems []*UTXOInfo) bool {
 	switch pos {
-	case 0: // component 2 (Amount Component)
+	case sortByAmount: // component 2 (Amount Component)
 		if ascendingOrder {
 			return elems[i].Amount.ToInt() > elems[j].Amount.ToInt()
 		}
 		return elems[i].Amount.ToInt() < elems[j].Amount.ToInt()
-	case 1: // component 3 (Address Component)
+	case sortByAddress: // component 3 (Address Component)
 		addresses := []string{elems[i].Address, elems[j].Address}
 		if ascendingOrder {
 			sort.Strings(addresses)
@@ -684,12 +1103,12 @@ func sortUTXOrows(i, j, pos int, ascendingOrder bool, elems []*UTXOInfo) bool {
 		}
 		sort.Sort(sort.Reverse(sort.StringSlice(addresses)))
 		return elems[i].Address == addresses[0]
-	case 2: // component 4 (Confirmations Component)
+	case sortByConfirmations: // component 4 (Confirmations Component)
 		if ascendingOrder {
 			return elems[i].Confirmations > elems[j].Confirmations
 		}
 		return elems[i].Confirmations < elems[j].Confirmations
-	case 3: // component 5 (Date Component)
+	case sortByDate: // component 5 (Date Component)
 		if ascendingOrder {
 			return elems[i].ReceiveTime.Unix() > elems[j].ReceiveTime.Unix()
 		}
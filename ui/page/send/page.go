@@ -3,6 +3,7 @@ package send
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"gioui.org/io/event"
 	"gioui.org/io/key"
@@ -25,6 +26,10 @@ const (
 
 	// MaxTxLabelSize defines the maximum number of characters to be allowed on
 	MaxTxLabelSize = 100
+
+	// balanceRefreshInterval is how often the selected wallet balance is
+	// refreshed when no tx or block notifications are received.
+	balanceRefreshInterval = time.Minute
 )
 
 var (
@@ -72,6 +77,8 @@ type Page struct {
 
 	toCoinSelection *cryptomaterial.Clickable
 	advanceOptions  *cryptomaterial.Collapsible
+	// importSignedTx is only displayed for watch-only wallets.
+	importSignedTx *cryptomaterial.Clickable
 
 	selectedUTXOs      selectedUTXOsInfo
 	navigateToSyncBtn  cryptomaterial.Button
@@ -192,15 +199,31 @@ func (pg *Page) pageFields() pageFields {
 func (pg *Page) initModalWalletSelector(wallet sharedW.Asset) {
 	pg.walletDropdown = components.NewWalletDropdown(pg.Load).
 		SetChangedCallback(func(w sharedW.Asset) {
-			pg.selectedWallet = w
-			if pg.accountDropdown != nil {
-				pg.accountDropdown.Setup(w, pg.sourceAccount)
-				go pg.feeRateSelector.UpdatedFeeRate(pg.selectedWallet)
-				pg.setAssetTypeForRecipients()
+			if !w.CanSign() {
+				if pg.selectedWallet != nil && pg.selectedWallet.GetWalletID() == w.GetWalletID() {
+					// The user already opted to export from this wallet.
+					return
+				}
+				// Watch-only wallets can only be used to export an unsigned
+				// transaction, keep the previous wallet unless the user opts
+				// to do that.
+				pg.walletDropdown.SetSelectedWallet(pg.selectedWallet)
+				pg.showWatchOnlyWalletInfo(w)
+				return
 			}
-
+			pg.walletSelected(w)
 		}).
+		SetPeriodicRefresh(balanceRefreshInterval).
+		EnableWatchOnlyWallets(wallet == nil).
 		Setup(wallet)
+	if selected := pg.walletDropdown.SelectedWallet(); selected != nil && !selected.CanSign() {
+		for _, w := range pg.walletDropdown.ListedWallets() {
+			if w.CanSign() {
+				pg.walletDropdown.SetSelectedWallet(w)
+				break
+			}
+		}
+	}
 	if pg.selectedWallet == nil {
 		pg.selectedWallet = pg.walletDropdown.SelectedWallet()
 	}
@@ -215,7 +238,9 @@ func (pg *Page) initModalWalletSelector(wallet sharedW.Asset) {
 			if pg.selectedWallet == nil {
 				return false
 			}
-			accountIsValid := account.Number != load.MaxInt32 && !pg.selectedWallet.IsWatchingOnlyWallet()
+			// Accounts of watch-only wallets are valid too, an unsigned
+			// transaction is exported for them instead of being sent.
+			accountIsValid := account.Number != load.MaxInt32
 
 			if pg.selectedWallet.ReadBoolConfigValueForKey(sharedW.AccountMixerConfigSet, false) &&
 				!pg.selectedWallet.ReadBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, false) {
@@ -241,9 +266,48 @@ func (pg *Page) initModalWalletSelector(wallet sharedW.Asset) {
 
 			return accountIsValid
 		}).
+		ShowBalanceBreakdown().
+		ShowFiatBalance(pg.fiatBalance).
+		SetPeriodicRefresh(balanceRefreshInterval).
 		Setup(pg.selectedWallet)
 }
 
+// fiatBalance returns the USD value of an account balance of the selected
+// wallet, empty if the exchange rate isn't available.
+func (pg *Page) fiatBalance(amount int64) string {
+	if pg.exchangeRate == -1 || !pg.usdExchangeSet || pg.selectedWallet == nil {
+		return ""
+	}
+	coin := pg.selectedWallet.ToAmount(amount).ToCoin()
+	return utils.FormatAsUSDString(pg.Printer, utils.CryptoToUSD(pg.exchangeRate, coin))
+}
+
+func (pg *Page) walletSelected(wallet sharedW.Asset) {
+	pg.selectedWallet = wallet
+	if pg.accountDropdown != nil {
+		pg.accountDropdown.Setup(wallet, pg.sourceAccount)
+		pg.feeRateSelector.UpdateMinFeeRate(pg.selectedWallet)
+		go pg.feeRateSelector.UpdatedFeeRate(pg.selectedWallet)
+		pg.setAssetTypeForRecipients()
+	}
+}
+
+// showWatchOnlyWalletInfo explains why the watch-only wallet can't be used to
+// send funds and offers to export an unsigned transaction from it instead.
+func (pg *Page) showWatchOnlyWalletInfo(wallet sharedW.Asset) {
+	info := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrWatchOnly)).
+		Body(values.StringF(values.StrWatchOnlyCantSign, wallet.GetWalletName())).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrExportUnsignedTx)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			pg.walletDropdown.SetSelectedWallet(wallet)
+			pg.walletSelected(wallet)
+			return true
+		})
+	pg.ParentWindow().ShowModal(info)
+}
+
 // RestyleWidgets restyles select widgets to match the current theme. This is
 // especially necessary when the dark mode setting is changed.
 func (pg *Page) RestyleWidgets() {
@@ -271,6 +335,7 @@ func (pg *Page) UpdateSelectedUTXOs(utxos []*sharedW.UnspentOutput) {
 // Part of the load.Page interface.
 func (pg *Page) OnNavigatedTo() {
 	pg.RestyleWidgets()
+	pg.accountDropdown.EnableSpendableInfo(pg.ParentWindow())
 	if pg.selectedWallet == nil {
 		return
 	}
@@ -280,7 +345,8 @@ func (pg *Page) OnNavigatedTo() {
 		return
 	}
 
-	pg.walletDropdown.ListenForTxNotifications(pg.ParentWindow()) // listener is stopped in OnNavigatedFrom()
+	pg.walletDropdown.ListenForTxNotifications(pg.ParentWindow())  // listener is stopped in OnNavigatedFrom()
+	pg.accountDropdown.ListenForTxNotifications(pg.ParentWindow()) // listener is stopped in OnNavigatedFrom()
 
 	pg.usdExchangeSet = false
 	if pg.AssetsManager.ExchangeRateFetchingEnabled() {
@@ -291,6 +357,8 @@ func (pg *Page) OnNavigatedTo() {
 		pg.validateAndConstructTx()
 	}
 
+	pg.feeRateSelector.UpdateMinFeeRate(pg.selectedWallet)
+
 	if pg.selectedWallet.GetAssetType() == libUtil.BTCWalletAsset && pg.isFeerateAPIApproved() {
 		// This API call may take sometime to return. Call this before and cache
 		// results.
@@ -386,6 +454,7 @@ func (pg *Page) constructTx() {
 	pg.feeRateSelector.EstSignedSize = fmt.Sprintf("%d Bytes", feeAndSize.EstimatedSignedSize)
 	pg.feeRateSelector.TxFee = pg.txFee
 	pg.feeRateSelector.SetFeerate(feeAndSize.FeeRate)
+	pg.feeRateSelector.UpdateConfirmationEstimate(wal, feeAndSize.FeeRate)
 	pg.totalCost = totalCost.String()
 	pg.balanceAfterSend = balanceAfterSend.String()
 	pg.sendAmount = wal.ToAmount(totalAmount).String()
@@ -454,6 +523,20 @@ func (pg *Page) addSendDestination() (sharedW.AssetAmount, sharedW.AssetAmount,
 
 }
 
+// recipientsAmount returns the total amount sent to the recipients or zero if
+// the maximum amount is sent to any of them.
+func (pg *Page) recipientsAmount() int64 {
+	var total int64
+	for _, recipient := range pg.recipients {
+		amount, sendMax := recipient.validAmount()
+		if sendMax {
+			return 0
+		}
+		total += amount
+	}
+	return total
+}
+
 func (pg *Page) isAllRecipientValidated() bool {
 	isValid := true
 	for i := range pg.recipients {
@@ -500,7 +583,14 @@ func (pg *Page) setRecipientsAmountErr(err error) {
 }
 
 func (pg *Page) allRecipientsIsValid() bool {
-	isValid := pg.selectedWallet != nil && pg.selectedWallet.IsSynced()
+	isValid := pg.selectedWallet != nil
+	if isValid && pg.selectedWallet.CanSign() {
+		isValid, _ = sharedW.CanTransact(pg.selectedWallet)
+	} else if isValid {
+		// Watch-only wallets only export an unsigned transaction, they need
+		// to be synced to pick its inputs but not to be connected.
+		isValid = pg.selectedWallet.IsSynced()
+	}
 	for i := range pg.recipients {
 		recipient := pg.recipients[i]
 		isValid = isValid && recipient.isValidated()
@@ -585,6 +675,9 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 	if pg.feeRateSelector.SaveRate.Clicked(gtx) {
 		pg.feeRateSelector.OnEditRateClicked(pg.selectedWallet)
 	}
+	if pg.feeRateSelector.HandleEstimatedRate(gtx, pg.selectedWallet) {
+		pg.validateAndConstructTx()
+	}
 
 	pg.nextButton.SetEnabled(pg.allRecipientsIsValid())
 
@@ -602,14 +695,28 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 	// 	go pg.fetchExchangeRate()
 	// }
 
+	if pg.importSignedTx.Clicked(gtx) {
+		pg.showImportSignedTxModal()
+	}
+
 	if pg.toCoinSelection.Clicked(gtx) {
 		if (len(pg.getDestinationAddresses()) == len(pg.recipients)) || !pg.recipients[0].isSendToAddress() {
-			pg.ParentNavigator().Display(NewManualCoinSelectionPage(pg.Load, pg))
+			coinSelectionPage := NewManualCoinSelectionPage(pg.Load, pg).
+				SetSelectedUTXOCallback(pg.UpdateSelectedUTXOs).
+				SetTargetAmount(pg.recipientsAmount(), feeRatePerkB(pg.selectedWallet))
+			// Show the previous choice of coins if the source account hasn't
+			// changed since.
+			if pg.selectedUTXOs.sourceAccount == pg.accountDropdown.SelectedAccount() {
+				coinSelectionPage.PreselectUTXOs(pg.selectedUTXOs.selectedUTXOs)
+			}
+			pg.ParentNavigator().Display(coinSelectionPage)
 		}
 	}
 
 	if pg.nextButton.Clicked(gtx) {
-		if pg.selectedWallet.IsUnsignedTxExist() {
+		if !pg.selectedWallet.CanSign() {
+			pg.exportUnsignedTx()
+		} else if pg.selectedWallet.IsUnsignedTxExist() {
 			pg.confirmTxModal = newSendConfirmModal(pg.Load, pg.authoredTxData, pg.selectedWallet, func(txHash string) {
 				if pg.modalLayout == nil {
 					transaction, err := pg.selectedWallet.GetTransactionRaw(txHash)
@@ -715,6 +822,7 @@ func (pg *Page) HandleKeyPress(_ *key.Event) {}
 // Part of the load.Page interface.
 func (pg *Page) OnNavigatedFrom() {
 	pg.walletDropdown.StopTxNtfnListener()
+	pg.accountDropdown.StopTxNtfnListener()
 }
 
 func (pg *Page) isFeerateAPIApproved() bool {
@@ -80,7 +80,7 @@ func (rp *recipient) cleanAllErrors() {
 
 func (rp *recipient) setDestinationAssetType(assetType libUtil.AssetType) {
 	rp.amount.setAssetType(assetType)
-	rp.sendDestination.initDestinationWalletSelector(assetType)
+	rp.sendDestination.setAssetType(assetType)
 }
 
 func (rp *recipient) isAccountValid(sourceAccount, account *sharedW.Account) bool {
@@ -227,7 +227,7 @@ func (rp *recipient) recipientLayout(index int, showIcon bool) layout.Widget {
 			layout.Rigid(func(gtx C) D {
 				layoutBody := func(gtx C) D {
 					txt := fmt.Sprintf("%s %s", values.String(values.StrDestination), values.String(values.StrAddress))
-					return rp.contentWrapper(gtx, txt, rp.sendDestination.destinationAddressEditor.Layout)
+					return rp.contentWrapper(gtx, txt, rp.destinationAddressLayout)
 				}
 
 				if !rp.isShowSendToWallet() {
@@ -246,6 +246,20 @@ func (rp *recipient) recipientLayout(index int, showIcon bool) layout.Widget {
 	}
 }
 
+func (rp *recipient) destinationAddressLayout(gtx C) D {
+	return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+		layout.Rigid(rp.sendDestination.destinationAddressEditor.Layout),
+		layout.Rigid(func(gtx C) D {
+			if rp.sendDestination.addressInfo == "" {
+				return D{}
+			}
+			lbl := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize14), rp.sendDestination.addressInfo)
+			lbl.Color = rp.Theme.Color.GrayText2
+			return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
+		}),
+	)
+}
+
 func (rp *recipient) topLayout(gtx C, index int) D {
 	txt := fmt.Sprintf("%s: %s %v", values.String(values.StrTo), values.String(values.StrRecipient), index)
 	titleTxt := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize16), txt)
@@ -353,7 +367,27 @@ func (rp *recipient) addressAndAmountlayout(gtx C) D {
 		}
 
 	}
-	return rp.contentWrapper(gtx, values.String(values.StrAmount), widget)
+	return rp.contentWrapper(gtx, values.String(values.StrAmount), func(gtx C) D {
+		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+			layout.Rigid(widget),
+			layout.Rigid(rp.amountDetailLayout),
+		)
+	})
+}
+
+// amountDetailLayout displays the entered amount in the smallest unit of the
+// asset to help catch amounts that are off by orders of magnitude. The USD
+// value is only included if the rate source is available.
+func (rp *recipient) amountDetailLayout(gtx C) D {
+	showFiat := rp.pageParam().exchangeRate != -1 && rp.pageParam().usdExchangeSet
+	detail := rp.amount.amountDetail(rp.Printer, showFiat)
+	if detail == "" || rp.amount.amountEditor.HasError() {
+		return D{}
+	}
+
+	lbl := rp.Theme.Label(values.TextSizeTransform(rp.IsMobileView(), values.TextSize14), detail)
+	lbl.Color = rp.Theme.Color.GrayText2
+	return layout.Inset{Top: values.MarginPadding4}.Layout(gtx, lbl.Layout)
 }
 
 func (rp *recipient) txLabelSection(gtx C) D {
@@ -7,14 +7,14 @@ import (
 	"gioui.org/io/key"
 	"gioui.org/layout"
 	"gioui.org/widget"
-	"github.com/btcsuite/btcd/btcutil"
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
-	"github.com/decred/dcrd/dcrutil/v4"
+	"golang.org/x/text/message"
 )
 
 type sendAmount struct {
@@ -91,10 +91,7 @@ func (sa *sendAmount) setAmount(amount int64) {
 	// TODO: this workaround ignores the change events from the
 	// amount input to avoid construct tx cycle.
 	sa.sendMaxChangeEvent = sa.SendMax
-	amountSet := dcrutil.Amount(amount).ToCoin()
-	if sa.assetType == libUtil.BTCWalletAsset {
-		amountSet = btcutil.Amount(amount).ToBTC()
-	}
+	amountSet := utils.AssetAmount(sa.assetType, amount).ToCoin()
 	sa.amountEditor.Editor.SetText(fmt.Sprintf("%.8f", amountSet))
 
 	if sa.exchangeRate != -1 {
@@ -125,10 +122,52 @@ func (sa *sendAmount) validAmount() (int64, bool, error) {
 		return -1, sa.SendMax, err
 	}
 
-	if sa.assetType == libUtil.BTCWalletAsset {
-		return btc.AmountSatoshi(amount), sa.SendMax, nil
+	return atomAmount(sa.assetType, amount), sa.SendMax, nil
+}
+
+// amountDetail returns the entered amount in the smallest unit of the asset
+// and, if showFiat is true and the exchange rate is known, its USD value. An
+// empty string is returned if no valid amount is entered.
+func (sa *sendAmount) amountDetail(p *message.Printer, showFiat bool) string {
+	amount, err := strconv.ParseFloat(sa.amountEditor.Editor.Text(), 64)
+	if err != nil || amount <= 0 {
+		return ""
+	}
+
+	atoms := atomAmount(sa.assetType, amount)
+	if atoms < 0 {
+		return ""
+	}
+
+	detail := p.Sprintf("%d %s", atoms, smallestUnitName(sa.assetType))
+	if showFiat && sa.exchangeRate != -1 {
+		usdAmount := utils.CryptoToUSD(sa.exchangeRate, amount)
+		detail += " · ≈ " + utils.FormatAsUSDString(p, usdAmount)
+	}
+	return detail
+}
+
+// atomAmount converts a coin amount to the smallest unit of the asset.
+func atomAmount(assetType libUtil.AssetType, amount float64) int64 {
+	switch assetType {
+	case libUtil.BTCWalletAsset:
+		return btc.AmountSatoshi(amount)
+	case libUtil.LTCWalletAsset:
+		return ltc.AmountLitoshi(amount)
+	default:
+		return dcr.AmountAtom(amount)
+	}
+}
+
+func smallestUnitName(assetType libUtil.AssetType) string {
+	switch assetType {
+	case libUtil.BTCWalletAsset:
+		return values.String(values.StrSats)
+	case libUtil.LTCWalletAsset:
+		return values.String(values.StrLitoshis)
+	default:
+		return values.String(values.StrAtoms)
 	}
-	return dcr.AmountAtom(amount), sa.SendMax, nil
 }
 
 func (sa *sendAmount) validateAmount() {
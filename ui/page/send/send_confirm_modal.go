@@ -21,6 +21,10 @@ import (
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
+// broadcastTxOperationID identifies a tx broadcast in the pending operations
+// registry.
+const broadcastTxOperationID = "send_broadcast_tx"
+
 type sendConfirmModal struct {
 	*load.Load
 	*cryptomaterial.Modal
@@ -86,9 +90,18 @@ func (scm *sendConfirmModal) broadcastTransaction() {
 		return
 	}
 
+	if ok, reason := sharedW.CanTransact(scm.asset); !ok {
+		scm.SetError(values.TranslateErr(reason))
+		return
+	}
+
 	scm.setLoading(true)
+	scm.PendingOperations.Add(broadcastTxOperationID, values.String(values.StrBroadcastingTx))
 	go func() {
-		defer scm.setLoading(false)
+		defer func() {
+			scm.PendingOperations.Remove(broadcastTxOperationID)
+			scm.setLoading(false)
+		}()
 		txHash, err := scm.asset.Broadcast(password, scm.txLabel)
 		if err != nil {
 			scm.SetError(err.Error())
@@ -6,6 +6,7 @@ import (
 
 	"gioui.org/widget"
 
+	"github.com/crypto-power/cryptopower/libwallet/addresshelper"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
@@ -25,6 +26,9 @@ type destination struct {
 	addressChanged           func()
 	destinationAddressEditor cryptomaterial.Editor
 	sourceAccount            *sharedW.Account
+	// addressInfo holds the chunked display form of a valid BTC destination
+	// address alongside its detected type and network.
+	addressInfo string
 
 	walletDropdown  *components.WalletDropdown
 	accountDropdown *components.AccountDropdown
@@ -58,20 +62,31 @@ func (dst *destination) initDestinationWalletSelector(assetType libUtil.AssetTyp
 				_ = dst.accountDropdown.Setup(wallet)
 			}
 		}).
-		WalletValidator(func(wallet sharedW.Asset) bool {
-			if dst.sourceAccount == nil {
-				return true
+		WalletDisabled(func(wallet sharedW.Asset) (bool, string) {
+			// The source wallet is listed even if it has no other account
+			// so users can tell why they can't send to it.
+			if dst.sourceAccount == nil || wallet.GetWalletID() != dst.sourceAccount.WalletID {
+				return false, ""
 			}
-			if wallet.GetWalletID() == dst.sourceAccount.WalletID {
-				account, err := wallet.GetAccountsRaw()
-				if err != nil || len(account.Accounts) < 2 {
-					return false
-				}
+			account, err := wallet.GetAccountsRaw()
+			if err != nil || len(account.Accounts) < 2 {
+				return true, values.String(values.StrNoOtherAccount)
 			}
-			return true
+			return false, ""
 		}).
 		EnableWatchOnlyWallets(true).
 		Setup()
+	dst.initDestinationAccountSelector()
+}
+
+// setAssetType lists the wallets of the provided asset type only, the
+// destination accounts are those of the wallet selected then.
+func (dst *destination) setAssetType(assetType libUtil.AssetType) {
+	dst.walletDropdown.FilterAsset(assetType).Setup()
+	dst.initDestinationAccountSelector()
+}
+
+func (dst *destination) initDestinationAccountSelector() {
 	dst.accountDropdown = components.NewAccountDropdown(dst.Load).
 		SetChangedCallback(func(_ *sharedW.Account) {
 			dst.addressChanged()
@@ -125,11 +140,17 @@ func (dst *destination) destinationAccount() *sharedW.Account {
 func (dst *destination) validateDestinationAddress() (string, error) {
 	address := dst.destinationAddressEditor.Editor.Text()
 	address = strings.TrimSpace(address)
+	dst.addressInfo = ""
 
 	if address == "" {
 		return address, fmt.Errorf(values.String(values.StrDestinationMissing))
 	}
 
+	if dst.walletDropdown != nil && dst.walletDropdown.SelectedWallet() != nil &&
+		dst.walletDropdown.SelectedWallet().GetAssetType() == libUtil.BTCWalletAsset {
+		return dst.validateBTCDestinationAddress(address)
+	}
+
 	if dst.walletDropdown != nil && dst.walletDropdown.SelectedWallet() != nil && dst.walletDropdown.SelectedWallet().IsAddressValid(address) {
 		dst.destinationAddressEditor.SetError("")
 		return address, nil
@@ -138,6 +159,24 @@ func (dst *destination) validateDestinationAddress() (string, error) {
 	return address, fmt.Errorf(values.String(values.StrInvalidAddress))
 }
 
+// validateBTCDestinationAddress strictly validates a raw BTC address, rejecting
+// segwit addresses with a broken checksum, and prepares its display info.
+func (dst *destination) validateBTCDestinationAddress(address string) (string, error) {
+	params, err := libUtil.BTCChainParams(dst.AssetsManager.NetType())
+	if err != nil {
+		return address, err
+	}
+
+	info, err := addresshelper.DecodeBTCAddress(address, params)
+	if err != nil {
+		return address, err
+	}
+
+	dst.destinationAddressEditor.SetError("")
+	dst.addressInfo = fmt.Sprintf("%s (%s, %s)", addresshelper.FormatAddressForDisplay(address), info.Type, info.Network)
+	return address, nil
+}
+
 func (dst *destination) validate() bool {
 	if dst.isSendToAddress() {
 		_, err := dst.validateDestinationAddress()
@@ -160,6 +199,7 @@ func (dst *destination) setError(errMsg string) {
 }
 
 func (dst *destination) clearAddressInput() {
+	dst.addressInfo = ""
 	dst.destinationAddressEditor.SetError("")
 	dst.destinationAddressEditor.Editor.SetText("")
 }
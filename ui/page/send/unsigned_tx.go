@@ -0,0 +1,124 @@
+package send
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libUtil "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// exportUnsignedTx saves the transaction authored for the selected watch-only
+// wallet to a file so it can be signed offline.
+func (pg *Page) exportUnsignedTx() {
+	wallet := pg.selectedWallet
+	if !wallet.IsUnsignedTxExist() {
+		return
+	}
+
+	go func() {
+		fileName, err := writeUnsignedTx(wallet, filepath.Join(pg.AssetsManager.RootDir(), "exports"))
+		if err != nil {
+			errModal := modal.NewErrorModal(pg.Load, fmt.Errorf("error exporting the unsigned transaction: %v", err).Error(), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(errModal)
+			return
+		}
+
+		infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrUnsignedTxExported, fileName), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(infoModal)
+	}()
+}
+
+// showImportSignedTxModal asks for a transaction exported with
+// exportUnsignedTx and signed offline, then broadcasts it. The modal runs the
+// callback off the UI thread and keeps its buttons disabled until it returns,
+// the same as the send confirm modal does while broadcasting.
+func (pg *Page) showImportSignedTxModal() {
+	wallet := pg.selectedWallet
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrSignedTxHint)).
+		PositiveButtonStyle(pg.Theme.Color.Primary, pg.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(input string, tm *modal.TextInputModal) bool {
+			if ok, reason := sharedW.CanBroadcast(wallet); !ok {
+				tm.SetError(values.TranslateErr(reason))
+				return false
+			}
+
+			data, err := readSignedTx(input)
+			if err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+
+			pg.PendingOperations.Add(broadcastTxOperationID, values.String(values.StrBroadcastingTx))
+			_, err = wallet.ImportSignedTransaction(data)
+			pg.PendingOperations.Remove(broadcastTxOperationID)
+			if err != nil {
+				tm.SetError(err.Error())
+				pg.ParentWindow().Reload()
+				return false
+			}
+
+			pg.resetRecipientsFields()
+			pg.clearEstimates()
+			infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrTxSent), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(infoModal)
+			return true
+		})
+	textModal.Title(values.String(values.StrImportSignedTx)).
+		SetPositiveButtonText(values.String(values.StrSend))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+// readSignedTx returns the transaction in input, which is either the hex
+// encoded transaction or the path of a file holding it hex encoded or in
+// binary.
+func readSignedTx(input string) ([]byte, error) {
+	input = strings.TrimSpace(input)
+	if data, err := hex.DecodeString(input); err == nil {
+		return data, nil
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile error: %w", err)
+	}
+	if decoded, err := hex.DecodeString(strings.TrimSpace(string(data))); err == nil {
+		return decoded, nil
+	}
+	return data, nil
+}
+
+// writeUnsignedTx writes the unsigned transaction of the wallet to a new file
+// in dir and returns the file's path. BTC transactions are written as binary
+// PSBTs, raw transactions of the other assets are hex encoded.
+func writeUnsignedTx(wallet sharedW.Asset, dir string) (string, error) {
+	data, err := wallet.CreateUnsignedTransaction()
+	if err != nil {
+		return "", err
+	}
+
+	ext := "hex"
+	if wallet.GetAssetType() == libUtil.BTCWalletAsset {
+		ext = "psbt"
+	} else {
+		data = []byte(hex.EncodeToString(data))
+	}
+
+	if err := os.MkdirAll(dir, libUtil.UserFilePerm); err != nil {
+		return "", fmt.Errorf("os.MkdirAll error: %w", err)
+	}
+
+	name := fmt.Sprintf("unsigned_tx_%s_%d.%s", wallet.GetAssetType().ToStringLower(), time.Now().Unix(), ext)
+	fileName := filepath.Join(dir, name)
+	if err := os.WriteFile(fileName, data, libUtil.UserFilePerm); err != nil {
+		return "", fmt.Errorf("os.WriteFile error: %w", err)
+	}
+	return fileName, nil
+}
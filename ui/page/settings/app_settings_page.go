@@ -1,9 +1,11 @@
 package settings
 
 import (
+	"fmt"
 	"image/color"
 	"io"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,7 +29,17 @@ import (
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
-const AppSettingsPageID = "Settings"
+const (
+	AppSettingsPageID = "Settings"
+
+	// firstWalletOptionKey is the startup wallet option that selects the
+	// first wallet on launch.
+	firstWalletOptionKey = "first_wallet"
+
+	// minSearchableOptions is the number of startup wallet options above
+	// which the options can be searched.
+	minSearchableOptions = 6
+)
 
 type (
 	C = layout.Context
@@ -54,11 +66,15 @@ type AppSettingsPage struct {
 	network                 *cryptomaterial.Clickable
 	language                *cryptomaterial.Clickable
 	currency                *cryptomaterial.Clickable
+	startupWallet           *cryptomaterial.Clickable
+	walletOrder             *cryptomaterial.Clickable
 	help                    *cryptomaterial.Clickable
 	about                   *cryptomaterial.Clickable
 	appearanceMode          *cryptomaterial.Clickable
 	startupPassword         *cryptomaterial.Switch
 	transactionNotification *cryptomaterial.Switch
+	advancedMode            *cryptomaterial.Switch
+	lockOnBackground        *cryptomaterial.Switch
 	backButton              cryptomaterial.IconButton
 	infoButton              cryptomaterial.IconButton
 	networkInfoButton       cryptomaterial.IconButton
@@ -74,10 +90,12 @@ type AppSettingsPage struct {
 	feeRateAPI    *cryptomaterial.Switch
 	vspAPI        *cryptomaterial.Switch
 	updateAPI     *cryptomaterial.Switch
+	explorerAPI   *cryptomaterial.Switch
 	privacyActive *cryptomaterial.Switch
 
-	isDarkModeOn      bool
-	isStartupPassword bool
+	isDarkModeOn       bool
+	isStartupPassword  bool
+	startupWalletLabel string
 }
 
 func NewAppSettingsPage(l *load.Load) *AppSettingsPage {
@@ -90,17 +108,22 @@ func NewAppSettingsPage(l *load.Load) *AppSettingsPage {
 
 		startupPassword:         l.Theme.Switch(),
 		transactionNotification: l.Theme.Switch(),
+		advancedMode:            l.Theme.Switch(),
+		lockOnBackground:        l.Theme.Switch(),
 		governanceAPI:           l.Theme.Switch(),
 		exchangeAPI:             l.Theme.Switch(),
 		feeRateAPI:              l.Theme.Switch(),
 		vspAPI:                  l.Theme.Switch(),
 		updateAPI:               l.Theme.Switch(),
+		explorerAPI:             l.Theme.Switch(),
 		privacyActive:           l.Theme.Switch(),
 
 		changeStartupPass: l.Theme.NewClickable(false),
 		network:           l.Theme.NewClickable(false),
 		language:          l.Theme.NewClickable(false),
 		currency:          l.Theme.NewClickable(false),
+		startupWallet:     l.Theme.NewClickable(false),
+		walletOrder:       l.Theme.NewClickable(false),
 		help:              l.Theme.NewClickable(false),
 		about:             l.Theme.NewClickable(false),
 		appearanceMode:    l.Theme.NewClickable(false),
@@ -304,9 +327,36 @@ func (pg *AppSettingsPage) general() layout.Widget {
 					}
 					return pg.clickableRow(gtx, languageRow)
 				}),
+				layout.Rigid(func(gtx C) D {
+					startupWalletRow := row{
+						title:     values.String(values.StrStartupWallet),
+						clickable: pg.startupWallet,
+						label:     pg.Theme.Body2(pg.startupWalletLabel),
+					}
+					return pg.clickableRow(gtx, startupWalletRow)
+				}),
+				layout.Rigid(func(gtx C) D {
+					// There is nothing to order with a single wallet.
+					if len(pg.AssetsManager.AllWallets()) < 2 {
+						return D{}
+					}
+					label := pg.Theme.Body2("")
+					if pg.AssetsManager.HasCustomWalletOrder() {
+						label = pg.Theme.Body2(values.String(values.StrCustomOrder))
+					}
+					walletOrderRow := row{
+						title:     values.String(values.StrWalletOrder),
+						clickable: pg.walletOrder,
+						label:     label,
+					}
+					return pg.clickableRow(gtx, walletOrderRow)
+				}),
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrTxNotification), pg.transactionNotification)
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrAdvancedMode), pg.advancedMode)
+				}),
 			)
 		})
 	}
@@ -344,6 +394,9 @@ func (pg *AppSettingsPage) networkSettings() layout.Widget {
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrUpdateAPI), pg.updateAPI)
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrExplorerAPI), pg.explorerAPI)
+				}),
 			)
 		})
 	}
@@ -385,6 +438,9 @@ func (pg *AppSettingsPage) security() layout.Widget {
 				layout.Rigid(func(gtx C) D {
 					return pg.subSectionSwitch(gtx, values.String(values.StrStartupPassword), pg.startupPassword)
 				}),
+				layout.Rigid(func(gtx C) D {
+					return pg.subSectionSwitch(gtx, values.String(values.StrLockOnBackground), pg.lockOnBackground)
+				}),
 				layout.Rigid(func(gtx C) D {
 					if pg.isStartupPassword {
 						changeStartupPassRow := row{
@@ -515,6 +571,28 @@ func (pg *AppSettingsPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(langSelectorModal)
 	}
 
+	if pg.startupWallet.Clicked(gtx) {
+		options := pg.startupWalletOptions()
+		startupWalletModal := preference.NewListPreference(pg.Load, "", pg.currentStartupWalletKey(), options).
+			IsWallet(true).
+			Searchable(len(options) > minSearchableOptions).
+			Title(values.StrStartupWallet).
+			UpdateValues(func(key string) {
+				walletID, account, ok := parseStartupWalletKey(key)
+				if ok {
+					pg.AssetsManager.SetStartupWallet(walletID, account)
+				} else {
+					pg.AssetsManager.ClearStartupWallet()
+				}
+				pg.updateStartupWalletLabel()
+			})
+		pg.ParentWindow().ShowModal(startupWalletModal)
+	}
+
+	if pg.walletOrder.Clicked(gtx) {
+		pg.showWalletOrderModal()
+	}
+
 	if pg.backButton.Button.Clicked(gtx) {
 		pg.ParentNavigator().CloseCurrentPage()
 	}
@@ -537,6 +615,12 @@ func (pg *AppSettingsPage) HandleUserInteractions(gtx C) {
 	if pg.transactionNotification.Changed(gtx) {
 		pg.AssetsManager.SetTransactionsNotifications(pg.transactionNotification.IsChecked())
 	}
+	if pg.advancedMode.Changed(gtx) {
+		pg.AssetsManager.SetAdvancedMode(pg.advancedMode.IsChecked())
+	}
+	if pg.lockOnBackground.Changed(gtx) {
+		pg.AssetsManager.SetLockOnBackground(pg.lockOnBackground.IsChecked())
+	}
 	if pg.governanceAPI.Changed(gtx) {
 		pg.AssetsManager.SetHTTPAPIPrivacyMode(libutils.GovernanceHTTPAPI, pg.governanceAPI.IsChecked())
 	}
@@ -552,6 +636,9 @@ func (pg *AppSettingsPage) HandleUserInteractions(gtx C) {
 	if pg.updateAPI.Changed(gtx) {
 		pg.AssetsManager.SetHTTPAPIPrivacyMode(libutils.UpdateAPI, pg.updateAPI.IsChecked())
 	}
+	if pg.explorerAPI.Changed(gtx) {
+		pg.AssetsManager.SetHTTPAPIPrivacyMode(libutils.ExplorerAPI, pg.explorerAPI.IsChecked())
+	}
 
 	if pg.privacyActive.Changed(gtx) {
 		pg.AssetsManager.SetPrivacyMode(pg.privacyActive.IsChecked())
@@ -822,7 +909,84 @@ func (pg *AppSettingsPage) updateSettingOptions() {
 		pg.isStartupPassword = true
 	}
 
+	pg.setInitialSwitchStatus(pg.advancedMode, pg.IsAdvancedModeOn())
+	pg.setInitialSwitchStatus(pg.lockOnBackground, pg.AssetsManager.IsLockOnBackgroundOn())
 	pg.updatePrivacySettings()
+	pg.updateStartupWalletLabel()
+}
+
+// startupWalletOptions returns an option for every account of every wallet,
+// preceded by the option to select the first wallet on launch.
+func (pg *AppSettingsPage) startupWalletOptions() []preference.ItemPreference {
+	options := []preference.ItemPreference{
+		{Key: firstWalletOptionKey, Value: values.String(values.StrFirstWallet)},
+	}
+	for _, wallet := range pg.AssetsManager.AllWallets() {
+		accounts, err := wallet.GetAccountsRaw()
+		if err != nil {
+			log.Errorf("error reading accounts for wallet %s: %v", wallet.GetWalletName(), err)
+			continue
+		}
+		for _, account := range accounts.Accounts {
+			if account.Number == load.MaxInt32 {
+				continue
+			}
+			options = append(options, preference.ItemPreference{
+				Key:   startupWalletKey(wallet.GetWalletID(), account.Number),
+				Value: fmt.Sprintf("%s - %s", wallet.GetWalletName(), account.Name),
+			})
+		}
+	}
+	return options
+}
+
+// currentStartupWalletKey returns the option key of the current startup wallet.
+func (pg *AppSettingsPage) currentStartupWalletKey() string {
+	if !pg.AssetsManager.IsStartupWalletSet() {
+		return firstWalletOptionKey
+	}
+	wallet, account := pg.AssetsManager.StartupWallet()
+	if wallet == nil || account < 0 {
+		return firstWalletOptionKey
+	}
+	return startupWalletKey(wallet.GetWalletID(), account)
+}
+
+func (pg *AppSettingsPage) updateStartupWalletLabel() {
+	pg.startupWalletLabel = values.String(values.StrFirstWallet)
+	if !pg.AssetsManager.IsStartupWalletSet() {
+		return
+	}
+	wallet, accountNumber := pg.AssetsManager.StartupWallet()
+	if wallet == nil {
+		return
+	}
+	pg.startupWalletLabel = wallet.GetWalletName()
+	if account, err := wallet.GetAccount(accountNumber); err == nil {
+		pg.startupWalletLabel = fmt.Sprintf("%s - %s", wallet.GetWalletName(), account.Name)
+	}
+}
+
+func startupWalletKey(walletID int, account int32) string {
+	return fmt.Sprintf("%d:%d", walletID, account)
+}
+
+// parseStartupWalletKey parses an option key created by startupWalletKey. ok
+// is false if the key does not identify a wallet account.
+func parseStartupWalletKey(key string) (walletID int, account int32, ok bool) {
+	id, acct, found := strings.Cut(key, ":")
+	if !found {
+		return 0, 0, false
+	}
+	walletID, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, 0, false
+	}
+	accountNumber, err := strconv.ParseInt(acct, 10, 32)
+	if err != nil {
+		return 0, 0, false
+	}
+	return walletID, int32(accountNumber), true
 }
 
 func (pg *AppSettingsPage) updatePrivacySettings() {
@@ -835,6 +999,7 @@ func (pg *AppSettingsPage) updatePrivacySettings() {
 		pg.setInitialSwitchStatus(pg.feeRateAPI, pg.AssetsManager.IsHTTPAPIPrivacyModeOff(libutils.FeeRateHTTPAPI))
 		pg.setInitialSwitchStatus(pg.vspAPI, pg.AssetsManager.IsHTTPAPIPrivacyModeOff(libutils.VspAPI))
 		pg.setInitialSwitchStatus(pg.updateAPI, pg.AssetsManager.IsHTTPAPIPrivacyModeOff(libutils.UpdateAPI))
+		pg.setInitialSwitchStatus(pg.explorerAPI, pg.AssetsManager.IsHTTPAPIPrivacyModeOff(libutils.ExplorerAPI))
 	}
 }
 
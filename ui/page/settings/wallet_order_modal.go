@@ -0,0 +1,99 @@
+package settings
+
+import (
+	"fmt"
+
+	"gioui.org/layout"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// walletOrderRow is a wallet listed in the wallet order modal with the
+// buttons that move it up and down the list.
+type walletOrderRow struct {
+	wallet   sharedW.Asset
+	moveUp   *cryptomaterial.Clickable
+	moveDown *cryptomaterial.Clickable
+}
+
+// showWalletOrderModal lists the wallets in the order they are displayed and
+// lets the user move them up or down. The order is only saved once the user
+// confirms it.
+func (pg *AppSettingsPage) showWalletOrderModal() {
+	wallets := pg.AssetsManager.AllWallets()
+	rows := make([]*walletOrderRow, 0, len(wallets))
+	for _, wallet := range wallets {
+		rows = append(rows, &walletOrderRow{
+			wallet:   wallet,
+			moveUp:   pg.Theme.NewClickable(true),
+			moveDown: pg.Theme.NewClickable(true),
+		})
+	}
+
+	orderModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrWalletOrder)).
+		UseCustomWidget(func(gtx C) D {
+			for i, row := range rows {
+				if row.moveUp.Clicked(gtx) && i > 0 {
+					rows[i-1], rows[i] = rows[i], rows[i-1]
+				}
+				if row.moveDown.Clicked(gtx) && i < len(rows)-1 {
+					rows[i], rows[i+1] = rows[i+1], rows[i]
+				}
+			}
+
+			children := make([]layout.FlexChild, 0, len(rows))
+			for i, row := range rows {
+				i, row := i, row
+				children = append(children, layout.Rigid(func(gtx C) D {
+					return pg.walletOrderRowLayout(gtx, row, i > 0, i < len(rows)-1)
+				}))
+			}
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, children...)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrSave)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			walletIDs := make([]int, 0, len(rows))
+			for _, row := range rows {
+				walletIDs = append(walletIDs, row.wallet.GetWalletID())
+			}
+			pg.AssetsManager.SetWalletOrder(walletIDs)
+			return true
+		})
+	pg.ParentWindow().ShowModal(orderModal)
+}
+
+// walletOrderRowLayout displays the wallet name and asset with the buttons
+// that move the wallet, a button is hidden if the wallet can't move that way.
+func (pg *AppSettingsPage) walletOrderRowLayout(gtx C, row *walletOrderRow, canMoveUp, canMoveDown bool) D {
+	moveButton := func(gtx C, clickable *cryptomaterial.Clickable, icon *cryptomaterial.Icon, enabled bool) D {
+		if !enabled {
+			// Keep the space of the button so the rows stay aligned.
+			return layout.Spacer{Width: values.MarginPadding28}.Layout(gtx)
+		}
+		return clickable.Layout(gtx, func(gtx C) D {
+			return layout.UniformInset(values.MarginPadding4).Layout(gtx, icon.Layout20dp)
+		})
+	}
+
+	return layout.Inset{Bottom: values.MarginPadding8}.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+			layout.Flexed(1, func(gtx C) D {
+				name := fmt.Sprintf("%s (%s)", row.wallet.GetWalletName(), row.wallet.GetAssetType())
+				lbl := pg.Theme.Label(values.TextSizeTransform(pg.Load.IsMobileView(), values.TextSize16), name)
+				lbl.MaxLines = 1
+				return lbl.Layout(gtx)
+			}),
+			layout.Rigid(func(gtx C) D {
+				return moveButton(gtx, row.moveUp, pg.Theme.NewIcon(pg.Theme.Icons.ChevronUp), canMoveUp)
+			}),
+			layout.Rigid(func(gtx C) D {
+				return moveButton(gtx, row.moveDown, pg.Theme.NewIcon(pg.Theme.Icons.ChevronDown), canMoveDown)
+			}),
+		)
+	})
+}
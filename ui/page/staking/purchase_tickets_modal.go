@@ -0,0 +1,191 @@
+package staking
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"gioui.org/font"
+	"gioui.org/layout"
+	"gioui.org/widget"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/load"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// purchaseTicketsModal collects the account, VSP and number of tickets for a
+// manual ticket purchase. The purchase itself is confirmed with the wallet
+// passphrase by the caller.
+type purchaseTicketsModal struct {
+	*load.Load
+	*cryptomaterial.Modal
+
+	onPurchase func(account *sharedW.Account, vsp *dcr.VSP, numTickets int32)
+
+	cancel      cryptomaterial.Button
+	purchaseBtn cryptomaterial.Button
+
+	ticketCountEditor cryptomaterial.Editor
+	accountDropdown   *components.AccountDropdown
+	vspSelector       *components.VSPSelector
+
+	dcrImpl *dcr.Asset
+	// cancelVSPListLoad stops loading the known VSPs when the modal is
+	// dismissed.
+	cancelVSPListLoad context.CancelFunc
+}
+
+func newPurchaseTicketsModal(l *load.Load, wallet *dcr.Asset) *purchaseTicketsModal {
+	pt := &purchaseTicketsModal{
+		Load:  l,
+		Modal: l.Theme.ModalFloatTitle("purchase_tickets_modal", l.IsMobileView(), nil),
+
+		cancel:      l.Theme.OutlineButton(values.String(values.StrCancel)),
+		purchaseBtn: l.Theme.Button(values.String(values.StrPurchaseTickets)),
+		vspSelector: components.NewVSPSelector(l, wallet).Title(values.String(values.StrSelectVSP)),
+		dcrImpl:     wallet,
+	}
+
+	pt.ticketCountEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrNumberOfTickets))
+	pt.ticketCountEditor.Editor.SingleLine = true
+	pt.ticketCountEditor.Editor.SetText("1")
+
+	pt.purchaseBtn.SetEnabled(false)
+
+	return pt
+}
+
+// OnPurchase sets the callback invoked with the selected account, VSP and
+// number of tickets once the user confirms the purchase details.
+func (pt *purchaseTicketsModal) OnPurchase(onPurchase func(account *sharedW.Account, vsp *dcr.VSP, numTickets int32)) *purchaseTicketsModal {
+	pt.onPurchase = onPurchase
+	return pt
+}
+
+func (pt *purchaseTicketsModal) OnResume() {
+	pt.accountDropdown = components.NewAccountDropdown(pt.Load).
+		SetChangedCallback(func(_ *sharedW.Account) {}).
+		AccountValidator(ticketPurchaseAccountValidator(pt.dcrImpl)).
+		ShowBalanceBreakdown().
+		OnEmpty(func() {
+			if !pt.IsShown() {
+				return
+			}
+			pt.Dismiss()
+			pt.Toast.NotifyError(values.String(values.StrNoValidAccountFound))
+		})
+	if account, err := components.GetTicketPurchaseAccount(pt.dcrImpl); err == nil {
+		_ = pt.accountDropdown.Setup(pt.dcrImpl, account)
+	}
+	if pt.accountDropdown.SelectedAccount() == nil {
+		_ = pt.accountDropdown.Setup(pt.dcrImpl)
+	}
+	if !pt.IsShown() {
+		return // dismissed because no account can purchase tickets
+	}
+	pt.accountDropdown.ListenForTxNotifications(pt.ParentWindow()) // listener is stopped in OnDismiss()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pt.cancelVSPListLoad = cancel
+	go pt.dcrImpl.LoadVSPList(ctx) // canceled in OnDismiss()
+
+	if pt.dcrImpl.TicketBuyerConfigIsSet() {
+		pt.vspSelector.SelectVSP(pt.dcrImpl.AutoTicketsBuyerConfig().VspHost)
+	}
+}
+
+func (pt *purchaseTicketsModal) OnDismiss() {
+	pt.accountDropdown.StopTxNtfnListener()
+	if pt.cancelVSPListLoad != nil {
+		pt.cancelVSPListLoad()
+		pt.cancelVSPListLoad = nil
+	}
+}
+
+func (pt *purchaseTicketsModal) Layout(gtx C) D {
+	l := []layout.Widget{
+		func(gtx C) D {
+			t := pt.Theme.H6(values.String(values.StrPurchaseTickets))
+			t.TextSize = values.TextSizeTransform(pt.IsMobileView(), values.TextSize20)
+			t.Font.Weight = font.SemiBold
+			return t.Layout(gtx)
+		},
+		func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{
+						Top:    values.MarginPadding8,
+						Bottom: values.MarginPadding16,
+					}.Layout(gtx, func(gtx C) D {
+						return pt.accountDropdown.Layout(gtx, "")
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					pt.ticketCountEditor.TextSize = values.TextSizeTransform(pt.IsMobileView(), values.TextSize14)
+					return pt.ticketCountEditor.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return components.VerticalInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
+						return pt.vspSelector.Layout(pt.ParentWindow(), gtx)
+					})
+				}),
+			)
+		},
+		func(gtx C) D {
+			return layout.E.Layout(gtx, func(gtx C) D {
+				return layout.Flex{Axis: layout.Horizontal}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						return layout.Inset{
+							Right: values.MarginPadding4,
+						}.Layout(gtx, pt.cancel.Layout)
+					}),
+					layout.Rigid(pt.purchaseBtn.Layout),
+				)
+			})
+		},
+	}
+
+	return pt.Modal.Layout(gtx, l)
+}
+
+// ticketCount parses the number of tickets entered, it returns false if the
+// value isn't a whole number greater than zero.
+func (pt *purchaseTicketsModal) ticketCount() (int32, bool) {
+	count, err := strconv.ParseInt(strings.TrimSpace(pt.ticketCountEditor.Editor.Text()), 10, 32)
+	if err != nil || count <= 0 {
+		return 0, false
+	}
+	return int32(count), true
+}
+
+func (pt *purchaseTicketsModal) canPurchase() bool {
+	if pt.vspSelector.SelectedVSP() == nil || pt.accountDropdown.SelectedAccount() == nil {
+		return false
+	}
+
+	return pt.ticketCountEditor.Editor.Text() != ""
+}
+
+func (pt *purchaseTicketsModal) Handle(gtx C) {
+	pt.accountDropdown.Handle(gtx)
+	pt.purchaseBtn.SetEnabled(pt.canPurchase())
+
+	if pt.cancel.Clicked(gtx) || pt.Modal.BackdropClicked(gtx, true) {
+		pt.Dismiss()
+	}
+
+	if pt.purchaseBtn.Clicked(gtx) {
+		numTickets, ok := pt.ticketCount()
+		if !ok {
+			pt.ticketCountEditor.SetError(values.String(values.StrInvalidTicketCount))
+			return
+		}
+
+		pt.onPurchase(pt.accountDropdown.SelectedAccount(), pt.vspSelector.SelectedVSP(), numTickets)
+		pt.Dismiss()
+	}
+}
@@ -1,11 +1,14 @@
 package staking
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"gioui.org/font"
 	"gioui.org/layout"
 
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
 	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/values"
@@ -14,12 +17,53 @@ import (
 
 func (pg *Page) initStakePriceWidget() *Page {
 	pg.stakeSettings = pg.Theme.NewClickable(false)
+	pg.purchaseTicketsBtn = pg.Theme.Button(values.String(values.StrPurchaseTickets))
 	_, pg.infoButton = components.SubpageHeaderButtons(pg.Load)
+	pg.exportStatsBtn = pg.Theme.OutlineButton(values.String(values.StrExportCSV))
 
 	pg.stake = pg.Theme.Switch()
 	return pg
 }
 
+// nextTicketPriceChangeRemaining returns the time left until the ticket price
+// changes. The time left is estimated from the best block so it is counted
+// down from the time that block was mined.
+func (pg *Page) nextTicketPriceChangeRemaining() time.Duration {
+	secs, err := pg.dcrWallet.NextTicketPriceRemaining()
+	if err != nil {
+		return 0
+	}
+	bestBlockTime := time.Unix(pg.dcrWallet.GetBestBlock().Timestamp, 0)
+	return time.Until(bestBlockTime.Add(time.Duration(secs) * time.Second))
+}
+
+// startPriceChangeCountdown reloads the page every second so that the time
+// left until the next ticket price change counts down. It is stopped by
+// stopPriceChangeCountdown.
+func (pg *Page) startPriceChangeCountdown() {
+	ctx, cancel := context.WithCancel(pg.ticketContext)
+	pg.cancelPriceChangeCountdown = cancel
+	go func() {
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pg.ParentWindow().Reload()
+			}
+		}
+	}()
+}
+
+func (pg *Page) stopPriceChangeCountdown() {
+	if pg.cancelPriceChangeCountdown != nil {
+		pg.cancelPriceChangeCountdown()
+		pg.cancelPriceChangeCountdown = nil
+	}
+}
+
 func (pg *Page) pageHead(gtx C) D {
 	isMobile := pg.Load.IsMobileView()
 	txt := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize20), values.String(values.StrStakingInfo))
@@ -34,6 +78,10 @@ func (pg *Page) pageHead(gtx C) D {
 					return D{}
 				}
 				return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+					layout.Rigid(func(gtx C) D {
+						pg.purchaseTicketsBtn.TextSize = values.TextSizeTransform(isMobile, values.TextSize14)
+						return layout.Inset{Right: values.MarginPadding24}.Layout(gtx, pg.purchaseTicketsBtn.Layout)
+					}),
 					layout.Rigid(func(gtx C) D {
 						title := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize16), values.String(values.StrStake))
 						title.Color = pg.Theme.Color.GrayText2
@@ -84,12 +132,13 @@ func (pg *Page) stakePriceSection(gtx C) D {
 									}),
 									layout.Rigid(func(gtx C) D {
 										return layout.Center.Layout(gtx, func(gtx C) D {
-											if !pg.dcrWallet.IsSynced() || pg.dcrWallet.IsRescanning() || !pg.isTicketsPurchaseAllowed() {
+											price := pg.ticketPrice.value()
+											if price == "" || !pg.dcrWallet.IsSynced() || pg.dcrWallet.IsRescanning() || !pg.isTicketsPurchaseAllowed() {
 												title := pg.Theme.Label(textSize16, values.String(values.StrLoadingPrice))
 												title.Color = grayText
 												return title.Layout(gtx)
 											}
-											return components.LayoutBalanceWithUnitSizeBoldText(gtx, pg.Load, pg.ticketPrice, textSize16)
+											return components.LayoutBalanceWithUnitSizeBoldText(gtx, pg.Load, price, textSize16)
 										})
 									}),
 								)
@@ -100,19 +149,26 @@ func (pg *Page) stakePriceSection(gtx C) D {
 							live := fmt.Sprintf("%d", pg.ticketOverview.Live)
 							return pg.dataRows(gtx, values.String(values.StrLiveTickets), live, flexAxis, alignment)
 						}),
+						layout.Rigid(layout.Spacer{Height: values.MarginPadding12}.Layout),
+						layout.Rigid(func(gtx C) D {
+							stakedValue := pg.stakedValue
+							if stakedValue == "" || !pg.dcrWallet.IsSynced() {
+								stakedValue = values.String(values.StrNotAvailable)
+							}
+							return pg.dataRows(gtx, values.String(values.StrStakedValue), stakedValue, flexAxis, alignment)
+						}),
 					)
 				}
 
 				rightWg := func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
-							secs, _ := pg.dcrWallet.NextTicketPriceRemaining()
-							timeleft := nextTicketRemaining(int(secs))
-							return pg.dataRows(gtx, values.String(values.StrTimeLeft), timeleft, flexAxis, alignment)
+							timeLeft := nextTicketPriceChange(pg.nextTicketPriceChangeRemaining())
+							return pg.dataRows(gtx, values.String(values.StrNextPriceChangeIn), timeLeft, flexAxis, alignment)
 						}),
 						layout.Rigid(layout.Spacer{Height: values.MarginPadding12}.Layout),
 						layout.Rigid(func(gtx C) D {
-							canBuy := fmt.Sprintf("%d", pg.CalculateTotalTicketsCanBuy())
+							canBuy := fmt.Sprintf("%d", pg.ticketsCanBuy)
 							return pg.dataRows(gtx, values.String(values.StrCanBuy), canBuy, flexAxis, alignment)
 						}),
 					)
@@ -149,8 +205,40 @@ func (pg *Page) dataRows(gtx C, title1, value1 string, axis layout.Axis, alignme
 	})
 }
 
+// updateStakedValue recomputes the current value of the live and immature
+// tickets at the current ticket price and reloads the page. The fiat value may
+// be fetched from the rate source so this must not be called from a layout.
+func (pg *Page) updateStakedValue() {
+	defer pg.ParentWindow().Reload()
+
+	priceAtoms, ok := pg.ticketPrice.amount()
+	overview := pg.ticketOverview
+	if !ok || overview == nil {
+		pg.stakedValue = ""
+		return
+	}
+
+	atoms := int64(overview.Live+overview.Immature) * priceAtoms
+	stakedValue := dcrutil.Amount(atoms).String()
+	if atoms > 0 {
+		if fiatValue, err := pg.FiatValue(libutils.DCRWalletAsset, atoms); err == nil {
+			stakedValue = fmt.Sprintf("%s (%s)", stakedValue, fiatValue)
+		}
+	}
+	pg.stakedValue = stakedValue
+}
+
+// updateTicketsCanBuy recomputes the number of tickets the purchase account
+// can currently afford.
+func (pg *Page) updateTicketsCanBuy() {
+	pg.ticketsCanBuy = pg.CalculateTotalTicketsCanBuy()
+}
+
+// CalculateTotalTicketsCanBuy returns the number of tickets the spendable
+// balance of the purchase account can buy at the current ticket price while
+// keeping the balance the ticket buyer is configured to maintain.
 func (pg *Page) CalculateTotalTicketsCanBuy() int {
-	if !pg.dcrWallet.Synced() {
+	if !pg.dcrWallet.Synced() || pg.dcrWallet.IsWatchingOnlyWallet() {
 		return 0
 	}
 
@@ -165,12 +253,21 @@ func (pg *Page) CalculateTotalTicketsCanBuy() int {
 		log.Errorf("ticketPrice error: %v", err)
 		return 0
 	}
-	canBuy := totalBalance.Spendable.ToCoin() / dcrutil.Amount(ticketPrice.TicketPrice).ToCoin()
-	if canBuy < 0 {
-		canBuy = 0
-	}
+	balanceToMaintain := pg.dcrWallet.AutoTicketsBuyerConfig().BalanceToMaintain
+	return ticketsAffordable(totalBalance.Spendable.ToInt(), balanceToMaintain, ticketPrice.TicketPrice)
+}
 
-	return int(canBuy)
+// ticketsAffordable returns the number of tickets spendable can buy at
+// ticketPrice once balanceToMaintain is set aside. A negative
+// balanceToMaintain means none is configured.
+func ticketsAffordable(spendable, balanceToMaintain, ticketPrice int64) int {
+	if balanceToMaintain > 0 {
+		spendable -= balanceToMaintain
+	}
+	if ticketPrice <= 0 || spendable <= 0 {
+		return 0
+	}
+	return int(spendable / ticketPrice)
 }
 
 func (pg *Page) balanceProgressBarLayout(gtx C) D {
@@ -225,6 +322,11 @@ func (pg *Page) stakingRecordStatistics(gtx C) D {
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Immature), values.String(values.StrImmature))),
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Unmined), values.String(values.StrUmined))),
 		layout.Rigid(pg.stakingRecord(fmt.Sprintf("%d", pg.ticketOverview.Expired), values.String(values.StrExpired))),
+		layout.Rigid(func(gtx C) D {
+			// There is nothing to export without tickets.
+			pg.exportStatsBtn.SetEnabled(pg.ticketOverview.All > 0)
+			return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, pg.exportStatsBtn.Layout)
+		}),
 	)
 }
 
@@ -7,20 +7,65 @@ import (
 
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
 	"github.com/crypto-power/cryptopower/ui/page/components"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
 
+// ticketStatusFilters are the ticket statuses the tickets list can be filtered
+// by, in the order they are listed in the status dropdown.
+var ticketStatusFilters = []struct {
+	filter int32
+	label  string
+}{
+	{dcr.TxFilterTickets, values.StrAll},
+	{dcr.TxFilterUnmined, values.StrUmined},
+	{dcr.TxFilterImmature, values.StrImmature},
+	{dcr.TxFilterLive, values.StrLive},
+	{dcr.TxFilterVoted, values.StrVoted},
+	{dcr.TxFilterRevoked, values.StrRevoked},
+	{dcr.TxFilterExpired, values.StrExpired},
+}
+
 func (pg *Page) initTicketList() {
 	pg.ticketsList = pg.Theme.NewClickableList(layout.Vertical)
+
+	items := make([]cryptomaterial.DropDownItem, 0, len(ticketStatusFilters))
+	for _, status := range ticketStatusFilters {
+		items = append(items, cryptomaterial.DropDownItem{Text: values.String(status.label)})
+	}
+	pg.ticketStatusDropdown = pg.Theme.DropdownWithCustomPos(items, values.StakingDropdownGroup, 0, 0, false)
+	pg.ticketStatusDropdown.Width = values.DP118
+	pg.ticketStatusDropdown.CollapsedLayoutTextDirection = layout.E
+	pg.ticketStatusDropdown.FontWeight = font.SemiBold
+	pg.ticketStatusDropdown.Hoverable = false
+	pg.ticketStatusDropdown.SelectedItemIconColor = &pg.Theme.Color.Primary
+	pg.ticketStatusDropdown.ExpandedLayoutInset = layout.Inset{Top: values.MarginPadding35}
+	pg.ticketStatusDropdown.MakeCollapsedLayoutVisibleWhenExpanded = true
+	pg.ticketStatusDropdown.Background = &pg.Theme.Color.Gray4
+	pg.ticketStatusDropdown.SetConvertTextSize(pg.ConvertTextSize)
+}
+
+// selectedTicketFilter returns the filter of the status selected in the ticket
+// status dropdown. All tickets are listed by default.
+func (pg *Page) selectedTicketFilter() int32 {
+	index := pg.ticketStatusDropdown.SelectedIndex()
+	if index < 0 || index >= len(ticketStatusFilters) {
+		return dcr.TxFilterTickets
+	}
+	return ticketStatusFilters[index].filter
 }
 
 func (pg *Page) listenForTxNotifications() {
 	txAndBlockNotificationListener := &sharedW.TxAndBlockNotificationListener{
 		OnTransaction: func(_ int, _ *sharedW.Transaction) {
+			pg.updateTicketsCanBuy()
 			pg.ParentWindow().Reload()
 		},
-		OnBlockAttached: func(_ int, _ int32) {
+		OnBlockAttached: func(_ int, height int32) {
+			pg.bestBlockHeight.Store(height)
+			// The ticket price may change with every block.
+			pg.updateTicketsCanBuy()
 			pg.ParentWindow().Reload()
 		},
 	}
@@ -36,17 +81,82 @@ func (pg *Page) stopTxNotificationsListener() {
 }
 
 func (pg *Page) fetchTickets(offset, pageSize int32) ([]*transactionItem, int, bool, error) {
-	txs, err := pg.dcrWallet.GetTransactionsRaw(offset, pageSize, dcr.TxFilterTickets, true, "")
+	// Tickets are filtered when fetched so that the fetched data, which is
+	// also used to find the clicked ticket, only holds the listed tickets.
+	selectedFilter := pg.selectedTicketFilter()
+	txs, err := pg.dcrWallet.GetTransactionsRaw(offset, pageSize, selectedFilter, true, "")
 	if err != nil {
 		return nil, -1, false, err
 	}
+	sharedW.ApplyTxLabels(txs, pg.dcrWallet.TxLabelsMap())
 
 	tickets, err := pg.stakeToTransactionItems(txs, true, func(filter int32) bool {
-		return filter == dcr.TxFilterTickets
+		return filter == selectedFilter
 	})
 	return tickets, len(tickets), false, err
 }
 
+// refreshTicketsLayout displays the button that refreshes the tickets list, or
+// a spinner while the list is being refreshed.
+func (pg *Page) refreshTicketsLayout(gtx C) D {
+	size := values.MarginPaddingTransform(pg.IsMobileView(), values.MarginPadding18)
+	if pg.refreshingTickets.Load() {
+		gtx.Constraints.Max.X = gtx.Dp(size)
+		gtx.Constraints.Min.X = gtx.Constraints.Max.X
+		return pg.materialLoader.Layout(gtx)
+	}
+	return pg.refreshTicketsBtn.Layout(gtx, func(gtx C) D {
+		return pg.Theme.NewIcon(pg.Theme.Icons.NavigationRefresh).LayoutTransform(gtx, pg.IsMobileView(), values.MarginPadding18)
+	})
+}
+
+// vspFeeWarningLayout displays a badge below unspent tickets whose VSP fee
+// isn't confirmed. The fee status is fetched lazily as tickets are displayed.
+func (pg *Page) vspFeeWarningLayout(gtx C, ticket *transactionItem) D {
+	if ticket.status.TicketStatus != dcr.TicketStatusLive && ticket.status.TicketStatus != dcr.TicketStatusImmature {
+		return D{}
+	}
+	status := pg.vspFeeStatuses.warning(ticket.transaction.Hash)
+	if status == nil {
+		return D{}
+	}
+
+	return layout.Inset{Left: values.MarginPadding40, Bottom: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
+		return status.info.Layout(gtx, func(gtx C) D {
+			return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Right: values.MarginPadding4}.Layout(gtx, pg.Theme.Icons.AlertIcon.Layout16dp)
+				}),
+				layout.Rigid(func(gtx C) D {
+					lbl := pg.Theme.Label(values.TextSizeTransform(pg.IsMobileView(), values.TextSize12), values.String(values.StrVSPFeeUnconfirmed))
+					lbl.Color = pg.Theme.Color.Warning
+					return lbl.Layout(gtx)
+				}),
+			)
+		})
+	})
+}
+
+// ticketMaturityLayout draws the number of blocks left until the ticket
+// matures or, once mature, its confirmations. Nothing is drawn for voted,
+// revoked or expired tickets.
+func (pg *Page) ticketMaturityLayout(gtx C, ticket *transactionItem) D {
+	if ticket.ticketSpender != nil || ticket.status.TicketStatus == dcr.TicketStatusExpired {
+		return D{}
+	}
+
+	txt := ticketMaturityText(ticket.blockHeight, pg.bestBlockHeight.Load(), pg.dcrWallet.TicketMaturity())
+	if txt == "" {
+		return D{}
+	}
+
+	return layout.Inset{Left: values.MarginPadding40, Bottom: values.MarginPadding5}.Layout(gtx, func(gtx C) D {
+		lbl := pg.Theme.Label(values.TextSizeTransform(pg.IsMobileView(), values.TextSize12), txt)
+		lbl.Color = pg.Theme.Color.GrayText2
+		return lbl.Layout(gtx)
+	})
+}
+
 func (pg *Page) ticketListLayout(gtx C) D {
 	if pg.showMaterialLoader {
 		gtx.Constraints.Min.X = gtx.Constraints.Max.X
@@ -65,9 +175,22 @@ func (pg *Page) ticketListLayout(gtx C) D {
 				return layout.UniformInset(margin24).Layout(gtx, func(gtx C) D {
 					return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 						layout.Rigid(func(gtx C) D {
-							txt := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize20), values.String(values.StrTickets))
-							txt.Font.Weight = font.SemiBold
-							return txt.Layout(gtx)
+							return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+								layout.Rigid(func(gtx C) D {
+									txt := pg.Theme.Label(values.TextSizeTransform(isMobile, values.TextSize20), values.String(values.StrTickets))
+									txt.Font.Weight = font.SemiBold
+									return txt.Layout(gtx)
+								}),
+								layout.Flexed(1, func(gtx C) D {
+									return layout.E.Layout(gtx, func(gtx C) D {
+										return layout.Flex{Axis: layout.Horizontal, Alignment: layout.Middle}.Layout(gtx,
+											layout.Rigid(pg.ticketStatusDropdown.Layout),
+											layout.Rigid(layout.Spacer{Width: values.MarginPadding8}.Layout),
+											layout.Rigid(pg.refreshTicketsLayout),
+										)
+									})
+								}),
+							)
 						}),
 						layout.Rigid(layout.Spacer{Height: margin24}.Layout),
 						layout.Rigid(func(gtx C) D {
@@ -107,6 +230,12 @@ func (pg *Page) ticketListLayout(gtx C) D {
 											return components.LayoutTransactionRow(gtx, pg.Load, pg.dcrWallet, ticket.transaction, true)
 										})
 									}),
+									layout.Rigid(func(gtx C) D {
+										return pg.ticketMaturityLayout(gtx, ticket)
+									}),
+									layout.Rigid(func(gtx C) D {
+										return pg.vspFeeWarningLayout(gtx, ticket)
+									}),
 								)
 							})
 						}),
@@ -3,6 +3,7 @@ package staking
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"gioui.org/font"
 	"gioui.org/layout"
@@ -28,11 +29,15 @@ type ticketBuyerModal struct {
 	saveSettingsBtn cryptomaterial.Button
 
 	balToMaintainEditor cryptomaterial.Editor
+	maxTicketsEditor    cryptomaterial.Editor
 	accountDropdown     *components.AccountDropdown
 
 	vspSelector *components.VSPSelector
 
 	dcrImpl *dcr.Asset
+	// cancelVSPListLoad stops loading the known VSPs when the modal is
+	// dismissed.
+	cancelVSPListLoad context.CancelFunc
 }
 
 func newTicketBuyerModal(l *load.Load, wallet *dcr.Asset) *ticketBuyerModal {
@@ -48,6 +53,8 @@ func newTicketBuyerModal(l *load.Load, wallet *dcr.Asset) *ticketBuyerModal {
 
 	tb.balToMaintainEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrBalToMaintain))
 	tb.balToMaintainEditor.Editor.SingleLine = true
+	tb.maxTicketsEditor = l.Theme.Editor(new(widget.Editor), values.String(values.StrMaxTicketsPerWindow))
+	tb.maxTicketsEditor.Editor.SingleLine = true
 
 	tb.saveSettingsBtn.SetEnabled(false)
 
@@ -77,10 +84,10 @@ func (tb *ticketBuyerModal) OnResume() {
 	tb.initializeAccountSelector(tb.dcrImpl)
 	tb.accountDropdown.ListenForTxNotifications(tb.ParentWindow()) // listener is stopped in OnDismissed()
 
-	if len(tb.dcrImpl.KnownVSPs()) == 0 {
-		// TODO: Does this modal need this list?
-		go tb.dcrImpl.ReloadVSPList(context.TODO())
-	}
+	// The VSP selector lists the known VSPs.
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.cancelVSPListLoad = cancel
+	go tb.dcrImpl.LoadVSPList(ctx) // canceled in OnDismiss()
 
 	// loop through all available wallets and select the one with ticket buyer config.
 	// if non, set the selected wallet to the first.
@@ -99,6 +106,9 @@ func (tb *ticketBuyerModal) OnResume() {
 		tb.vspSelector.SelectVSP(tbConfig.VspHost)
 		w := tb.dcrImpl
 		tb.balToMaintainEditor.Editor.SetText(strconv.FormatFloat(w.ToAmount(tbConfig.BalanceToMaintain).ToCoin(), 'f', 0, 64))
+		if tbConfig.MaxTicketsPerWindow > 0 {
+			tb.maxTicketsEditor.Editor.SetText(strconv.Itoa(int(tbConfig.MaxTicketsPerWindow)))
+		}
 	}
 
 	if tb.accountDropdown.SelectedAccount() == nil {
@@ -128,6 +138,10 @@ func (tb *ticketBuyerModal) Layout(gtx C) D {
 					tb.balToMaintainEditor.TextSize = values.TextSizeTransform(tb.IsMobileView(), values.TextSize14)
 					return tb.balToMaintainEditor.Layout(gtx)
 				}),
+				layout.Rigid(func(gtx C) D {
+					tb.maxTicketsEditor.TextSize = values.TextSizeTransform(tb.IsMobileView(), values.TextSize14)
+					return layout.Inset{Top: values.MarginPadding16}.Layout(gtx, tb.maxTicketsEditor.Layout)
+				}),
 				layout.Rigid(func(gtx C) D {
 					return components.VerticalInset(values.MarginPadding16).Layout(gtx, func(gtx C) D {
 						return tb.vspSelector.Layout(tb.ParentWindow(), gtx)
@@ -169,23 +183,33 @@ func (tb *ticketBuyerModal) canSave() bool {
 func (tb *ticketBuyerModal) initializeAccountSelector(wallet *dcr.Asset) {
 	tb.accountDropdown = components.NewAccountDropdown(tb.Load).
 		SetChangedCallback(func(_ *sharedW.Account) {}).
-		AccountValidator(func(account *sharedW.Account) bool {
-			// Imported and watch only wallet accounts are invalid for sending
-			accountIsValid := account.Number != dcr.ImportedAccountNumber && !tb.dcrImpl.IsWatchingOnlyWallet()
-
-			if tb.dcrImpl.ReadBoolConfigValueForKey(sharedW.AccountMixerConfigSet, false) &&
-				!tb.dcrImpl.ReadBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, false) {
-				// Spending from unmixed accounts is disabled for the selected wallet
-				accountIsValid = account.Number == tb.dcrImpl.MixedAccountNumber()
-			}
-
-			return accountIsValid
-		}).
+		AccountValidator(ticketPurchaseAccountValidator(wallet)).
 		Setup(wallet)
 }
 
+// ticketPurchaseAccountValidator returns an account validator that only
+// accepts the accounts of the wallet that tickets can be purchased from.
+func ticketPurchaseAccountValidator(wallet *dcr.Asset) func(*sharedW.Account) bool {
+	return func(account *sharedW.Account) bool {
+		// Imported and watch only wallet accounts are invalid for sending
+		accountIsValid := account.Number != dcr.ImportedAccountNumber && !wallet.IsWatchingOnlyWallet()
+
+		if wallet.ReadBoolConfigValueForKey(sharedW.AccountMixerConfigSet, false) &&
+			!wallet.ReadBoolConfigValueForKey(sharedW.SpendUnmixedFundsKey, false) {
+			// Spending from unmixed accounts is disabled for the selected wallet
+			accountIsValid = account.Number == wallet.MixedAccountNumber()
+		}
+
+		return accountIsValid
+	}
+}
+
 func (tb *ticketBuyerModal) OnDismiss() {
 	tb.accountDropdown.StopTxNtfnListener()
+	if tb.cancelVSPListLoad != nil {
+		tb.cancelVSPListLoad()
+		tb.cancelVSPListLoad = nil
+	}
 }
 
 func (tb *ticketBuyerModal) Handle(gtx C) {
@@ -205,10 +229,20 @@ func (tb *ticketBuyerModal) Handle(gtx C) {
 			return
 		}
 
+		// An empty limit field means no limit.
+		var maxTickets int64
+		if text := strings.TrimSpace(tb.maxTicketsEditor.Editor.Text()); text != "" {
+			maxTickets, err = strconv.ParseInt(text, 10, 32)
+			if err != nil || maxTickets < 0 {
+				tb.maxTicketsEditor.SetError(values.String(values.StrInvalidMaxTickets))
+				return
+			}
+		}
+
 		balToMaintain := dcr.AmountAtom(amount)
 		account := tb.accountDropdown.SelectedAccount()
 
-		tb.dcrImpl.SetAutoTicketsBuyerConfig(vspHost, account.Number, balToMaintain)
+		tb.dcrImpl.SetAutoTicketsBuyerConfig(vspHost, account.Number, balToMaintain, int32(maxTickets))
 		tb.settingsSaved()
 		tb.Dismiss()
 	}
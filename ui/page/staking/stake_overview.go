@@ -3,6 +3,7 @@ package staking
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync/atomic"
 
 	"gioui.org/layout"
@@ -37,6 +38,11 @@ const (
 
 	// The ticket height limit helps separate the scrolling of the ticket list and the page
 	ticketHeight = 500
+
+	// Keys used to avoid stacking identical error modals when the ticket
+	// price or staking data is fetched repeatedly.
+	notSyncedModalKey  = "staking_not_synced"
+	fetchErrorModalKey = "staking_fetch_error"
 )
 
 type Page struct {
@@ -52,19 +58,36 @@ type Page struct {
 
 	ticketOverview *dcr.StakingOverview
 
-	ticketsList    *cryptomaterial.ClickableList
-	stakeSettings  *cryptomaterial.Clickable
-	stake          *cryptomaterial.Switch
-	infoButton     cryptomaterial.IconButton
-	materialLoader material.LoaderStyle
+	ticketsList          *cryptomaterial.ClickableList
+	ticketStatusDropdown *cryptomaterial.DropDown
+	stakeSettings        *cryptomaterial.Clickable
+	purchaseTicketsBtn   cryptomaterial.Button
+	stake                *cryptomaterial.Switch
+	infoButton           cryptomaterial.IconButton
+	exportStatsBtn       cryptomaterial.Button
+	materialLoader       material.LoaderStyle
 
-	ticketPrice        string
+	ticketPrice        *ticketPrice
 	totalRewards       string
+	ticketsCanBuy      int
+	stakedValue        string
 	showMaterialLoader bool
 
 	navToSettingsBtn cryptomaterial.Button
 	processingTicket uint32
 
+	cancelPriceChangeCountdown context.CancelFunc
+	// cancelVSPListLoad stops loading the known VSPs when the page is left.
+	cancelVSPListLoad context.CancelFunc
+
+	refreshTicketsBtn *cryptomaterial.Clickable
+	refreshingTickets atomic.Bool
+	vspFeeStatuses    *vspFeeStatuses
+
+	// bestBlockHeight is updated as blocks are attached so that the ticket
+	// rows show live confirmations.
+	bestBlockHeight atomic.Int32
+
 	dcrWallet *dcr.Asset
 
 	// ticketContext is a managed context instance that is shut once a shutdown
@@ -92,10 +115,23 @@ func NewStakingPage(l *load.Load, dcrWallet *dcr.Asset) *Page {
 	pg.scroll = components.NewScroll(l, pageSize, pg.fetchTickets)
 	pg.materialLoader = material.Loader(l.Theme.Base)
 	pg.ticketOverview = new(dcr.StakingOverview)
+	fetchTicketPrice := func() (*dcr.TicketPriceResponse, error) {
+		return libutils.Retry(pg.ticketContext, libutils.DefaultRetryPolicy, dcrWallet.TicketPrice)
+	}
+	pg.ticketPrice = newTicketPrice(fetchTicketPrice, dcrWallet.GetBestBlockHeight, pg.ticketPriceError, pg.updateStakedValue)
 	pg.initStakePriceWidget()
 	pg.initTicketList()
 
 	pg.navToSettingsBtn = l.Theme.Button(values.StringF(values.StrEnableAPI, values.String(values.StrVsp)))
+	pg.refreshTicketsBtn = l.Theme.NewClickable(true)
+	fetchVSPTicketInfo := func(hash string) (*dcr.VSPTicketInfo, error) {
+		return libutils.Retry(pg.ticketContext, libutils.DefaultRetryPolicy, func() (*dcr.VSPTicketInfo, error) {
+			return dcrWallet.VSPTicketInfo(hash)
+		})
+	}
+	pg.vspFeeStatuses = newVSPFeeStatuses(l.Theme, fetchVSPTicketInfo, func() {
+		pg.ParentWindow().Reload()
+	})
 
 	return pg
 }
@@ -108,11 +144,16 @@ func (pg *Page) OnNavigatedTo() {
 	// If staking is disabled no startup func should be called
 	// Layout will draw an overlay to show that stacking is disabled.
 
+	pg.startPriceChangeCountdown() // stopped in OnNavigatedFrom().
+	pg.bestBlockHeight.Store(pg.dcrWallet.GetBestBlockHeight())
+
 	isSyncingOrRescanning := !pg.dcrWallet.IsSynced() || pg.dcrWallet.IsRescanning()
 	if pg.isTicketsPurchaseAllowed() && !isSyncingOrRescanning {
-		pg.fetchTicketPrice()
+		pg.ticketPrice.refresh(true)
+		pg.updateTicketsCanBuy()
 
 		pg.loadPageData() // starts go routines to refresh the display which is just about to be displayed, ok?
+		pg.loadVSPList()  // canceled in OnNavigatedFrom().
 
 		pg.stake.SetChecked(pg.dcrWallet.IsAutoTicketsPurchaseActive())
 
@@ -128,35 +169,35 @@ func (pg *Page) OnNavigatedTo() {
 	}
 }
 
-// fetch ticket price only when the wallet is synced
-func (pg *Page) fetchTicketPrice() {
-	ticketPrice, err := pg.dcrWallet.TicketPrice()
-	if err != nil && !pg.dcrWallet.IsSynced() {
-		log.Error(err)
-		pg.ticketPrice = dcrutil.Amount(0).String()
+// ticketPriceError is called when the ticket price can't be fetched. The not
+// synced error modal is only shown if it isn't already displayed.
+func (pg *Page) ticketPriceError(err error) {
+	log.Errorf("ticketPrice error: %v", err)
+	if !pg.dcrWallet.IsSynced() {
 		errModal := modal.NewErrorModal(pg.Load, values.String(values.StrWalletNotSynced), modal.DefaultClickFunc())
-		pg.ParentWindow().ShowModal(errModal)
-	} else {
-		pg.ticketPrice = dcrutil.Amount(ticketPrice.TicketPrice).String()
+		pg.ParentWindow().ShowModalOnce(notSyncedModalKey, errModal)
 	}
 }
 
 func (pg *Page) setStakingButtonsState() {
 	// disable auto ticket purchase if wallet is not synced
 	pg.stake.SetEnabled(pg.dcrWallet.IsSynced() || !pg.dcrWallet.IsWatchingOnlyWallet())
+	// manual ticket purchases require a wallet that can transact
+	pg.purchaseTicketsBtn.SetEnabled(pg.canTransact())
+}
+
+// canTransact returns true if the wallet can sign and broadcast transactions.
+func (pg *Page) canTransact() bool {
+	ok, _ := sharedW.CanTransact(pg.dcrWallet)
+	return ok
 }
 
 func (pg *Page) loadPageData() {
 	go func() {
-		if len(pg.dcrWallet.KnownVSPs()) == 0 {
-			// TODO: Does this page need this list?
-			pg.dcrWallet.ReloadVSPList(context.TODO())
-		}
-
-		totalRewards, err := pg.dcrWallet.TotalStakingRewards()
+		totalRewards, err := libutils.Retry(pg.ticketContext, libutils.DefaultRetryPolicy, pg.dcrWallet.TotalStakingRewards)
 		if err != nil {
 			errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
-			pg.ParentWindow().ShowModal(errModal)
+			pg.ParentWindow().ShowModalOnce(fetchErrorModalKey, errModal)
 		} else {
 			pg.totalRewards = dcrutil.Amount(totalRewards).String()
 		}
@@ -164,11 +205,37 @@ func (pg *Page) loadPageData() {
 		overview, err := pg.dcrWallet.StakingOverview()
 		if err != nil {
 			errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
-			pg.ParentWindow().ShowModal(errModal)
+			pg.ParentWindow().ShowModalOnce(fetchErrorModalKey, errModal)
 		} else {
 			pg.ticketOverview = overview
 		}
 
+		pg.updateStakedValue()
+	}()
+}
+
+// loadVSPList loads the known VSPs in the background, unless they were loaded
+// recently, so the VSP selector of the ticket purchase modals can list them
+// without waiting.
+func (pg *Page) loadVSPList() {
+	ctx, cancel := context.WithCancel(pg.ticketContext)
+	pg.cancelVSPListLoad = cancel
+	go pg.dcrWallet.LoadVSPList(ctx)
+}
+
+// refreshTickets reloads the staking overview and the tickets list on demand.
+// It does nothing if a refresh is already in progress.
+func (pg *Page) refreshTickets() {
+	if !pg.refreshingTickets.CompareAndSwap(false, true) {
+		return
+	}
+
+	pg.vspFeeStatuses.reset()
+	pg.ticketPrice.refresh(true)
+	pg.loadPageData()
+	go func() {
+		pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
+		pg.refreshingTickets.Store(false)
 		pg.ParentWindow().Reload()
 	}()
 }
@@ -231,6 +298,22 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().Display(settings.NewAppSettingsPage(pg.Load))
 	}
 
+	if pg.refreshTicketsBtn.Clicked(gtx) {
+		pg.refreshTickets()
+	}
+
+	if pg.ticketStatusDropdown.Changed(gtx) {
+		go pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
+	}
+
+	if status := pg.vspFeeStatuses.clickedWarning(gtx); status != nil {
+		info := modal.NewCustomModal(pg.Load).
+			Title(values.String(values.StrVSPFeeUnconfirmed)).
+			Body(values.StringF(values.StrVSPFeeUnconfirmedInfo, status.vsp, status.feeStatus.String())).
+			SetPositiveButtonText(values.String(values.StrGotIt))
+		pg.ParentWindow().ShowModal(info)
+	}
+
 	if pg.stake.Changed(gtx) {
 		if pg.stake.IsChecked() {
 			if pg.dcrWallet.TicketBuyerConfigIsSet() {
@@ -272,13 +355,15 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(ticketBuyerModal)
 	}
 
-	secs, _ := pg.dcrWallet.NextTicketPriceRemaining()
-	if secs <= 0 {
-		pg.fetchTicketPrice()
+	if pg.purchaseTicketsBtn.Clicked(gtx) && pg.canTransact() {
+		purchaseModal := newPurchaseTicketsModal(pg.Load, pg.dcrWallet).
+			OnPurchase(pg.purchaseTicketsPasswordModal)
+		pg.ParentWindow().ShowModal(purchaseModal)
 	}
 
+	// The price is only fetched again once a new block is attached.
 	if pg.dcrWallet.IsSynced() {
-		pg.fetchTicketPrice()
+		pg.ticketPrice.refresh(false)
 	}
 
 	if clicked, selectedItem := pg.ticketsList.ItemClicked(); clicked {
@@ -286,41 +371,7 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 		ticketTx := tickets[selectedItem].transaction
 		pg.ParentNavigator().Display(tpage.NewTransactionDetailsPage(pg.Load, pg.dcrWallet, ticketTx))
 
-		// Check if this ticket is fully registered with a VSP
-		// and log any discrepancies.
-		// NOTE: Wallet needs to be unlocked to get any ticket info
-		// from the vsp. This is fine because we're only just logging
-		// but where it is necessary to display vsp-stored info, the
-		// wallet passphrase should be requested and used to unlock
-		// the wallet before calling this method.
-		ticketInfo, err := pg.dcrWallet.VSPTicketInfo(ticketTx.Hash)
-		if err != nil {
-			if err.Error() != libutils.ErrWalletLocked {
-				// Ignore the wallet is locked error.
-				log.Errorf("VSPTicketInfo error: %v", err)
-			}
-		} else {
-			if ticketInfo.FeeTxStatus != dcr.VSPFeeProcessConfirmed || !ticketInfo.ConfirmedByVSP {
-				log.Warnf("Ticket %s has unconfirmed fee tx with status %q, vsp %s",
-					ticketTx.Hash, ticketInfo.FeeTxStatus.String(), ticketInfo.VSP)
-			}
-
-			// Confirm that fee hasn't been paid, sender account exists, the wallet
-			// is unlocked and no previous ticket processing instance is running.
-			if ticketInfo.FeeTxStatus != dcr.VSPFeeProcessPaid && len(ticketTx.Inputs) == 1 &&
-				ticketInfo.Client != nil && atomic.CompareAndSwapUint32(&pg.processingTicket, 0, 1) {
-
-				log.Infof("Attempting to process the unconfirmed VSP fee for tx: %v", ticketTx.Hash)
-
-				err = ticketInfo.Client.Process(pg.ticketContext, ticketInfo.VSPTicket, nil)
-				if err != nil {
-					log.Errorf("processing the unconfirmed tx fee failed: %v", err)
-				}
-
-				// Reset the processing
-				atomic.StoreUint32(&pg.processingTicket, 0)
-			}
-		}
+		go pg.checkVSPTicket(ticketTx)
 	}
 
 	if pg.infoButton.Button.Clicked(gtx) {
@@ -328,6 +379,9 @@ func (pg *Page) HandleUserInteractions(gtx C) {
 			Title(values.String(values.StrStatistics)).
 			SetCancelable(true).
 			UseCustomWidget(func(gtx C) D {
+				if pg.exportStatsBtn.Clicked(gtx) {
+					pg.exportStakingStats()
+				}
 				return pg.stakingRecordStatistics(gtx)
 			}).
 			SetPositiveButtonText(values.String(values.StrGotIt))
@@ -341,6 +395,7 @@ func (pg *Page) ticketBuyerSettingsModal() {
 			pg.stake.SetChecked(false)
 		}).
 		OnSettingsSaved(func() {
+			pg.updateTicketsCanBuy()
 			pg.startTicketBuyerPasswordModal()
 			infoModal := modal.NewSuccessModal(pg.Load, values.String(values.StrTicketSettingSaved), modal.DefaultClickFunc())
 			pg.ParentWindow().ShowModal(infoModal)
@@ -351,12 +406,17 @@ func (pg *Page) ticketBuyerSettingsModal() {
 func (pg *Page) startTicketBuyerPasswordModal() {
 	tbConfig := pg.dcrWallet.AutoTicketsBuyerConfig()
 	balToMaintain := pg.dcrWallet.ToAmount(tbConfig.BalanceToMaintain).ToCoin()
+	maxTickets := values.String(values.StrNoLimit)
+	if tbConfig.MaxTicketsPerWindow > 0 {
+		maxTickets = strconv.Itoa(int(tbConfig.MaxTicketsPerWindow))
+	}
 	name, err := pg.dcrWallet.AccountNameRaw(uint32(tbConfig.PurchaseAccount))
 	if err != nil {
 		errModal := modal.NewErrorModal(pg.Load, values.StringF(values.StrTicketError, err), modal.DefaultClickFunc())
 		pg.ParentWindow().ShowModal(errModal)
 		return
 	}
+	unmixedWarning, warningAcknowledged := pg.unmixedPurchaseWarning(tbConfig.PurchaseAccount)
 
 	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
 		EnableName(false).
@@ -367,10 +427,13 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrWalletToPurchaseFrom, pg.dcrWallet.GetWalletName())).Layout),
 				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrSelectedAccount, name)).Layout),
-				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrBalToMaintainValue, balToMaintain)).Layout), layout.Rigid(func(gtx C) D {
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrBalToMaintainValue, balToMaintain)).Layout),
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrMaxTicketsPerWindowValue, maxTickets)).Layout),
+				layout.Rigid(func(gtx C) D {
 					label := pg.Theme.Label(values.TextSize14, fmt.Sprintf("VSP: %s", tbConfig.VspHost))
 					return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, label.Layout)
 				}),
+				layout.Rigid(unmixedWarning),
 				layout.Rigid(func(gtx C) D {
 					return cryptomaterial.LinearLayout{
 						Width:      cryptomaterial.MatchParent,
@@ -398,6 +461,7 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 				}),
 			)
 		}).
+		SetPositiveButtonCondition(warningAcknowledged).
 		SetNegativeButtonCallback(func() {
 			_ = pg.dcrWallet.StopAutoTicketsPurchase()
 			pg.stake.SetChecked(false)
@@ -405,8 +469,8 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
 			pg.stake.SetChecked(false)
 
-			if !pg.dcrWallet.IsConnectedToNetwork() {
-				pm.SetError(values.String(values.StrNotConnected))
+			if ok, reason := sharedW.CanTransact(pg.dcrWallet); !ok {
+				pm.SetError(values.TranslateErr(reason))
 				_ = pg.dcrWallet.StopAutoTicketsPurchase() // Halt auto tickets purchase.
 				return false
 			}
@@ -426,6 +490,91 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 	pg.ParentWindow().ShowModal(walletPasswordModal)
 }
 
+// purchaseTicketsPasswordModal requests the wallet passphrase and purchases
+// numTickets tickets from the account using the vsp.
+func (pg *Page) purchaseTicketsPasswordModal(account *sharedW.Account, vsp *dcr.VSP, numTickets int32) {
+	unmixedWarning, warningAcknowledged := pg.unmixedPurchaseWarning(account.Number)
+	walletPasswordModal := modal.NewCreatePasswordModal(pg.Load).
+		EnableName(false).
+		EnableConfirmPassword(false).
+		Title(values.String(values.StrPurchaseTickets)).
+		UseCustomWidget(func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrWalletToPurchaseFrom, pg.dcrWallet.GetWalletName())).Layout),
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrSelectedAccount, account.AccountName)).Layout),
+				layout.Rigid(pg.Theme.Label(values.TextSize14, values.StringF(values.StrTicketsToPurchase, numTickets)).Layout),
+				layout.Rigid(func(gtx C) D {
+					label := pg.Theme.Label(values.TextSize14, fmt.Sprintf("VSP: %s", vsp.Host))
+					return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, label.Layout)
+				}),
+				layout.Rigid(unmixedWarning),
+			)
+		}).
+		SetPositiveButtonCondition(warningAcknowledged).
+		SetPositiveButtonCallback(func(_, password string, pm *modal.CreatePasswordModal) bool {
+			if ok, reason := sharedW.CanTransact(pg.dcrWallet); !ok {
+				pm.SetError(values.TranslateErr(reason))
+				return false
+			}
+
+			var vspPubKey []byte
+			if vsp.VspInfoResponse != nil {
+				vspPubKey = vsp.PubKey
+			}
+			tickets, err := pg.dcrWallet.PurchaseTickets(account.Number, numTickets, vsp.Host, password, vspPubKey)
+			if err != nil {
+				pm.SetError(err.Error())
+				return false
+			}
+			pm.Dismiss()
+
+			successModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrTicketsPurchased, len(tickets)), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(successModal)
+
+			pg.updateTicketsCanBuy()
+			go pg.scroll.FetchScrollData(false, pg.ParentWindow(), false)
+			return true
+		})
+	pg.ParentWindow().ShowModal(walletPasswordModal)
+}
+
+// unmixedPurchaseWarning returns a warning banner to show in the purchase
+// confirmation modal when tickets would be bought from an account other than
+// the mixed account while the mixer is set up. The banner has a "proceed
+// anyway" checkbox and acknowledged reports whether it was ticked. If no
+// warning is required, the banner is empty and acknowledged returns true.
+func (pg *Page) unmixedPurchaseWarning(account int32) (banner layout.Widget, acknowledged func() bool) {
+	mixerSet := pg.dcrWallet.ReadBoolConfigValueForKey(sharedW.AccountMixerConfigSet, false)
+	if !mixerSet || account == pg.dcrWallet.MixedAccountNumber() {
+		return func(C) D { return D{} }, func() bool { return true }
+	}
+
+	proceedAnyway := new(widget.Bool)
+	checkBox := pg.Theme.CheckBox(proceedAnyway, values.String(values.StrProceedAnyway))
+	banner = func(gtx C) D {
+		return layout.Inset{Bottom: values.MarginPadding12}.Layout(gtx, func(gtx C) D {
+			return cryptomaterial.LinearLayout{
+				Width:       cryptomaterial.MatchParent,
+				Height:      cryptomaterial.WrapContent,
+				Orientation: layout.Vertical,
+				Background:  pg.Theme.Color.Orange2,
+				Padding:     layout.UniformInset(values.MarginPadding12),
+				Border:      cryptomaterial.Border{Radius: cryptomaterial.Radius(8)},
+			}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					txt := pg.Theme.Label(values.TextSize14, values.String(values.StrUnmixedTicketPurchaseWarn))
+					txt.Color = pg.Theme.Color.Text
+					return txt.Layout(gtx)
+				}),
+				layout.Rigid(func(gtx C) D {
+					return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, checkBox.Layout)
+				}),
+			)
+		})
+	}
+	return banner, func() bool { return proceedAnyway.Value }
+}
+
 // OnNavigatedFrom is called when the page is about to be removed from
 // the displayed window. This method should ideally be used to disable
 // features that are irrelevant when the page is NOT displayed.
@@ -434,5 +583,51 @@ func (pg *Page) startTicketBuyerPasswordModal() {
 // components unless they'll be recreated in the OnNavigatedTo() method.
 // Part of the load.Page interface.
 func (pg *Page) OnNavigatedFrom() {
+	if pg.cancelVSPListLoad != nil {
+		pg.cancelVSPListLoad()
+		pg.cancelVSPListLoad = nil
+	}
+	pg.stopPriceChangeCountdown()
 	pg.stopTxNotificationsListener()
 }
+
+// checkVSPTicket checks if the ticket is fully registered with a VSP and logs
+// any discrepancies. The VSP may be contacted, it shouldn't be called from the
+// UI thread.
+// NOTE: Wallet needs to be unlocked to get any ticket info
+// from the vsp. This is fine because we're only just logging
+// but where it is necessary to display vsp-stored info, the
+// wallet passphrase should be requested and used to unlock
+// the wallet before calling this method.
+func (pg *Page) checkVSPTicket(ticketTx *sharedW.Transaction) {
+	ticketInfo, err := libutils.Retry(pg.ticketContext, libutils.DefaultRetryPolicy, func() (*dcr.VSPTicketInfo, error) {
+		return pg.dcrWallet.VSPTicketInfo(ticketTx.Hash)
+	})
+	if err != nil {
+		if err.Error() != libutils.ErrWalletLocked {
+			// Ignore the wallet is locked error.
+			log.Errorf("VSPTicketInfo error: %v", err)
+		}
+	} else {
+		if ticketInfo.FeeTxStatus != dcr.VSPFeeProcessConfirmed || !ticketInfo.ConfirmedByVSP {
+			log.Warnf("Ticket %s has unconfirmed fee tx with status %q, vsp %s",
+				ticketTx.Hash, ticketInfo.FeeTxStatus.String(), ticketInfo.VSP)
+		}
+
+		// Confirm that fee hasn't been paid, sender account exists, the wallet
+		// is unlocked and no previous ticket processing instance is running.
+		if ticketInfo.FeeTxStatus != dcr.VSPFeeProcessPaid && len(ticketTx.Inputs) == 1 &&
+			ticketInfo.Client != nil && atomic.CompareAndSwapUint32(&pg.processingTicket, 0, 1) {
+
+			log.Infof("Attempting to process the unconfirmed VSP fee for tx: %v", ticketTx.Hash)
+
+			err = ticketInfo.Client.Process(pg.ticketContext, ticketInfo.VSPTicket, nil)
+			if err != nil {
+				log.Errorf("processing the unconfirmed tx fee failed: %v", err)
+			}
+
+			// Reset the processing
+			atomic.StoreUint32(&pg.processingTicket, 0)
+		}
+	}
+}
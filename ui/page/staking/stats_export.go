@@ -0,0 +1,128 @@
+package staking
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/page/components"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// stakingStatsRecord is a ticket in the staking statistics export.
+type stakingStatsRecord struct {
+	hash         string
+	purchaseDate string
+	status       string
+	reward       string
+	vsp          string
+}
+
+// exportStakingStats writes the tickets of the wallet to a CSV file in the
+// exports directory and reports the outcome in a modal.
+func (pg *Page) exportStakingStats() {
+	go func() {
+		fileName := filepath.Join(pg.AssetsManager.RootDir(), "exports", fmt.Sprintf("staking_stats_%d.csv", time.Now().Unix()))
+		err := pg.writeStakingStatsFile(fileName)
+		if err != nil {
+			errModal := modal.NewErrorModal(pg.Load, fmt.Errorf("error exporting your staking statistics: %v", err).Error(), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(errModal)
+			return
+		}
+
+		infoModal := modal.NewSuccessModal(pg.Load, values.StringF(values.StrExportStakingStatsSuccessMsg, fileName), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(infoModal)
+	}()
+}
+
+func (pg *Page) writeStakingStatsFile(fileName string) error {
+	records, err := pg.stakingStatsRecords()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fileName), utils.UserFilePerm); err != nil {
+		return fmt.Errorf("os.MkdirAll error: %w", err)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("os.Create error: %w", err)
+	}
+
+	err = writeStakingStatsCSV(f, records)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(fileName)
+	}
+	return err
+}
+
+// stakingStatsRecords returns the tickets of the wallet with their status, the
+// reward of the vote and the VSP the ticket was purchased with.
+func (pg *Page) stakingStatsRecords() ([]*stakingStatsRecord, error) {
+	txs, err := pg.dcrWallet.GetTransactionsRaw(0, math.MaxInt32, dcr.TxFilterTickets, true, "")
+	if err != nil {
+		return nil, fmt.Errorf("wallet.GetTransactionsRaw error: %w", err)
+	}
+
+	records := make([]*stakingStatsRecord, 0, len(txs))
+	for _, tx := range txs {
+		ticketSpender, err := pg.dcrWallet.TicketSpender(tx.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("wallet.TicketSpender error: %w", err)
+		}
+
+		var reward string
+		if ticketSpender != nil && ticketSpender.Type == dcr.TxTypeVote {
+			reward = pg.dcrWallet.ToAmount(ticketSpender.VoteReward).String()
+		}
+
+		vsp, err := pg.dcrWallet.TicketVSPHost(tx.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("wallet.TicketVSPHost error: %w", err)
+		}
+
+		records = append(records, &stakingStatsRecord{
+			hash:         tx.Hash,
+			purchaseDate: time.Unix(tx.Timestamp, 0).String(),
+			status:       components.TransactionTitleIcon(pg.Load, pg.dcrWallet, tx).Title,
+			reward:       reward,
+			vsp:          vsp,
+		})
+	}
+	return records, nil
+}
+
+func writeStakingStatsCSV(w io.Writer, records []*stakingStatsRecord) error {
+	headers := []string{values.String(values.StrHash), values.String(values.StrPurchased), values.String(values.StrStatus), values.String(values.StrReward), values.String(values.StrVsp)}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = runtime.GOOS == "windows"
+	if err := writer.Write(headers); err != nil {
+		return fmt.Errorf("csv.Writer.Write error: %w", err)
+	}
+
+	for _, record := range records {
+		err := writer.Write([]string{record.hash, record.purchaseDate, record.status, record.reward, record.vsp})
+		if err != nil {
+			return fmt.Errorf("csv.Writer.Write error: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("csv.Writer error: %w", err)
+	}
+	return nil
+}
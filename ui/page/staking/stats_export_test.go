@@ -0,0 +1,35 @@
+package staking
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"testing"
+)
+
+func TestWriteStakingStatsCSV(t *testing.T) {
+	records := []*stakingStatsRecord{
+		{hash: "a1", purchaseDate: "2024-01-02", status: "Voted", reward: "0.01 DCR", vsp: "vsp.example.com"},
+		{hash: "b2", purchaseDate: "2024-03-04", status: "Live"},
+	}
+
+	var buf bytes.Buffer
+	if err := writeStakingStatsCSV(&buf, records); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	want := [][]string{
+		{"a1", "2024-01-02", "Voted", "0.01 DCR", "vsp.example.com"},
+		{"b2", "2024-03-04", "Live", "", ""},
+	}
+	if len(rows) != len(want)+1 {
+		t.Fatalf("expected a header and %d rows, got %d rows", len(want), len(rows))
+	}
+	if !reflect.DeepEqual(rows[1:], want) {
+		t.Fatalf("expected rows %v, got %v", want, rows[1:])
+	}
+}
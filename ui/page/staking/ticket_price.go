@@ -0,0 +1,92 @@
+package staking
+
+import (
+	"sync"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// ticketPrice caches the ticket price displayed on the staking page. The price
+// can only change when a block is attached, so it is only fetched again once
+// the best block has changed instead of on every frame. Fetching may hit the
+// network so it is done in the background, one fetch at a time.
+type ticketPrice struct {
+	mtx     sync.Mutex
+	price   string
+	atoms   int64
+	valid   bool
+	fetched bool
+	loading bool
+	// height is the best block height when the price was last fetched.
+	height int32
+
+	fetch           func() (*dcr.TicketPriceResponse, error)
+	bestBlockHeight func() int32
+	onError         func(err error)
+	onFetched       func()
+}
+
+func newTicketPrice(fetch func() (*dcr.TicketPriceResponse, error), bestBlockHeight func() int32, onError func(err error), onFetched func()) *ticketPrice {
+	return &ticketPrice{
+		fetch:           fetch,
+		bestBlockHeight: bestBlockHeight,
+		onError:         onError,
+		onFetched:       onFetched,
+	}
+}
+
+// value returns the last fetched price, or an empty string if the price is
+// being fetched for the first time.
+func (t *ticketPrice) value() string {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.price
+}
+
+// amount returns the last fetched price in atoms. The returned bool is false
+// if the price wasn't fetched or the last fetch failed.
+func (t *ticketPrice) amount() (int64, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.atoms, t.valid
+}
+
+// refresh starts fetching the price in the background if it wasn't fetched at
+// the current best block or if force is true. It does nothing if a fetch is
+// already in flight. A failed fetch is reported once and only retried after
+// the best block changes.
+func (t *ticketPrice) refresh(force bool) {
+	height := t.bestBlockHeight()
+
+	t.mtx.Lock()
+	if t.loading || (!force && t.fetched && t.height == height) {
+		t.mtx.Unlock()
+		return
+	}
+	t.fetched = true
+	t.loading = true
+	t.height = height
+	t.mtx.Unlock()
+
+	go t.fetchPrice()
+}
+
+func (t *ticketPrice) fetchPrice() {
+	resp, err := t.fetch()
+
+	t.mtx.Lock()
+	t.loading = false
+	t.valid = err == nil
+	t.atoms = 0
+	if t.valid {
+		t.atoms = resp.TicketPrice
+	}
+	t.price = dcrutil.Amount(t.atoms).String()
+	t.mtx.Unlock()
+
+	if err != nil {
+		t.onError(err)
+	}
+	t.onFetched()
+}
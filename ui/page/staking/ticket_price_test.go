@@ -0,0 +1,118 @@
+package staking
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+)
+
+// waitFetched waits for a background fetch of the ticket price to complete.
+func waitFetched(t *testing.T, fetched chan struct{}) {
+	t.Helper()
+	select {
+	case <-fetched:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ticket price was not fetched")
+	}
+}
+
+func TestTicketPriceErrorReportedOncePerBlock(t *testing.T) {
+	var height atomic.Int32
+	height.Store(100)
+	var fetches, errs atomic.Int32
+	fetched := make(chan struct{}, 10)
+	price := newTicketPrice(func() (*dcr.TicketPriceResponse, error) {
+		fetches.Add(1)
+		return nil, errors.New("wallet not synced")
+	}, height.Load, func(error) { errs.Add(1) }, func() { fetched <- struct{}{} })
+
+	price.refresh(false)
+	waitFetched(t, fetched)
+	if _, ok := price.amount(); ok {
+		t.Fatal("expected a failed fetch to leave the price invalid")
+	}
+
+	// Frames drawn at the same best block don't fetch the price again.
+	for i := 0; i < 10; i++ {
+		price.refresh(false)
+	}
+	if fetches.Load() != 1 || errs.Load() != 1 {
+		t.Fatalf("expected 1 fetch and 1 error, got %d fetches and %d errors", fetches.Load(), errs.Load())
+	}
+
+	height.Add(1)
+	price.refresh(false)
+	waitFetched(t, fetched)
+	price.refresh(false)
+	if fetches.Load() != 2 || errs.Load() != 2 {
+		t.Fatalf("expected a new block to refetch the price once, got %d fetches and %d errors", fetches.Load(), errs.Load())
+	}
+
+	price.refresh(true)
+	waitFetched(t, fetched)
+	if fetches.Load() != 3 {
+		t.Fatalf("expected a forced refresh to refetch the price, got %d fetches", fetches.Load())
+	}
+}
+
+func TestTicketPriceRefresh(t *testing.T) {
+	var height, atoms atomic.Int64
+	height.Store(1)
+	atoms.Store(1e8)
+	fetched := make(chan struct{}, 10)
+	price := newTicketPrice(func() (*dcr.TicketPriceResponse, error) {
+		return &dcr.TicketPriceResponse{TicketPrice: atoms.Load()}, nil
+	}, func() int32 { return int32(height.Load()) }, func(err error) {
+		t.Errorf("unexpected error: %v", err)
+	}, func() { fetched <- struct{}{} })
+
+	if got := price.value(); got != "" {
+		t.Fatalf("expected no price before the first fetch, got %s", got)
+	}
+
+	price.refresh(false)
+	waitFetched(t, fetched)
+	if got := price.value(); got != "1 DCR" {
+		t.Fatalf("expected price 1 DCR, got %s", got)
+	}
+	if atoms, ok := price.amount(); !ok || atoms != 1e8 {
+		t.Fatalf("expected a valid price of 1e8 atoms, got %d (valid: %v)", atoms, ok)
+	}
+
+	atoms.Store(2e8)
+	price.refresh(false)
+	if got := price.value(); got != "1 DCR" {
+		t.Fatalf("expected the cached price at the same block, got %s", got)
+	}
+
+	height.Add(1)
+	price.refresh(false)
+	waitFetched(t, fetched)
+	if got := price.value(); got != "2 DCR" {
+		t.Fatalf("expected price 2 DCR after a new block, got %s", got)
+	}
+}
+
+func TestTicketPriceOneFetchInFlight(t *testing.T) {
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	fetched := make(chan struct{}, 10)
+	price := newTicketPrice(func() (*dcr.TicketPriceResponse, error) {
+		fetches.Add(1)
+		<-release
+		return &dcr.TicketPriceResponse{TicketPrice: 1e8}, nil
+	}, func() int32 { return 1 }, func(error) {}, func() { fetched <- struct{}{} })
+
+	for i := 0; i < 10; i++ {
+		price.refresh(true)
+	}
+	close(release)
+	waitFetched(t, fetched)
+
+	if got := fetches.Load(); got != 1 {
+		t.Fatalf("expected 1 fetch in flight, got %d", got)
+	}
+}
@@ -22,11 +22,13 @@ type transactionItem struct {
 	ticketSpender *sharedW.Transaction
 	status        *components.TxStatus
 	confirmations int32
-	progress      float32
-	showProgress  bool
-	showTime      bool
-	purchaseTime  string
-	ticketAge     string
+	// blockHeight is the height the ticket was mined at, -1 if unmined.
+	blockHeight  int32
+	progress     float32
+	showProgress bool
+	showTime     bool
+	purchaseTime string
+	ticketAge    string
 
 	statusTooltip     *cryptomaterial.Tooltip
 	walletNameTooltip *cryptomaterial.Tooltip
@@ -102,6 +104,7 @@ func (pg *Page) stakeToTransactionItems(txs []*sharedW.Transaction, newestFirst
 			ticketSpender: ticketSpender,
 			status:        txStatus,
 			confirmations: dcr.Confirmations(bestBlockHeight, tx),
+			blockHeight:   tx.BlockHeight,
 			progress:      progress,
 			showProgress:  showProgress,
 			showTime:      showTime,
@@ -253,35 +256,55 @@ func ticketListLayout(gtx C, l *load.Load, wallet *dcr.Asset, ticket *transactio
 	})
 }
 
-func nextTicketRemaining(allsecs int) string {
-	if allsecs == 0 {
-		return "imminent"
+// ticketMaturityText describes how long a ticket mined at blockHeight has left
+// to mature or, once mature, its number of confirmations. An empty string is
+// returned for unmined tickets.
+func ticketMaturityText(blockHeight, bestBlockHeight, maturity int32) string {
+	if blockHeight < 0 {
+		return ""
 	}
-	str := ""
-	if allsecs > 604799 {
-		weeks := allsecs / 604800
-		allsecs %= 604800
-		str += fmt.Sprintf("%dw ", weeks)
-	}
-	if allsecs > 86399 {
-		days := allsecs / 86400
-		allsecs %= 86400
-		str += fmt.Sprintf("%dd ", days)
-	}
-	if allsecs > 3599 {
-		hours := allsecs / 3600
-		allsecs %= 3600
-		str += fmt.Sprintf("%dh ", hours)
+
+	confirmations := bestBlockHeight - blockHeight + 1
+	if confirmations <= maturity {
+		return values.StringF(values.StrMaturesInBlocks, maturity-confirmations+1)
 	}
-	if allsecs > 59 {
-		mins := allsecs / 60
-		allsecs %= 60
-		str += fmt.Sprintf("%dm ", mins)
+	return values.StringF(values.StrNConfirmations, confirmations)
+}
+
+// durationUnits are the units the time left until the next ticket price
+// change is displayed in, from the largest.
+var durationUnits = []struct {
+	secs   int
+	format string
+}{
+	{86400, values.StrDurationDays},
+	{3600, values.StrDurationHours},
+	{60, values.StrDurationMinutes},
+	{1, values.StrDurationSeconds},
+}
+
+// nextTicketPriceChange formats the time left until the next ticket price
+// change with its two largest units, e.g. "2d 4h".
+func nextTicketPriceChange(remaining time.Duration) string {
+	secs := int(remaining.Seconds())
+	if secs <= 0 {
+		return values.String(values.StrImminent)
 	}
-	if allsecs > 0 {
-		str += fmt.Sprintf("%ds ", allsecs)
+
+	for i, unit := range durationUnits {
+		if secs < unit.secs {
+			continue
+		}
+		str := values.StringF(unit.format, secs/unit.secs)
+		if i+1 < len(durationUnits) {
+			next := durationUnits[i+1]
+			if n := secs % unit.secs / next.secs; n > 0 {
+				str += " " + values.StringF(next.format, n)
+			}
+		}
+		return str
 	}
-	return str
+	return values.String(values.StrImminent)
 }
 
 func getTimeToMatureOrExpire(dcrWallet *dcr.Asset, tx *transactionItem) int {
@@ -0,0 +1,26 @@
+package staking
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+func TestTicketMaturityText(t *testing.T) {
+	const maturity = 256
+	tests := []struct {
+		name                    string
+		blockHeight, bestHeight int32
+		want                    string
+	}{
+		{"unmined", -1, 1000, ""},
+		{"just mined", 1000, 1000, values.StringF(values.StrMaturesInBlocks, 256)},
+		{"last immature block", 1000, 1255, values.StringF(values.StrMaturesInBlocks, 1)},
+		{"mature", 1000, 1256, values.StringF(values.StrNConfirmations, 257)},
+	}
+	for _, test := range tests {
+		if got := ticketMaturityText(test.blockHeight, test.bestHeight, maturity); got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.name, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package staking
+
+import (
+	"sync"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+)
+
+// maxVSPFeeFetches is the number of ticket statuses fetched at the same time,
+// the others are queued until a fetch completes.
+const maxVSPFeeFetches = 4
+
+// vspFeeStatus is the VSP fee status of a ticket.
+type vspFeeStatus struct {
+	fetched bool
+	// unconfirmed is true if the fee tx isn't confirmed or, if the VSP could
+	// be reached, the VSP hasn't confirmed the ticket.
+	unconfirmed bool
+	vsp         string
+	feeStatus   dcr.VSPFeeStatus
+
+	// info displays why the status is a concern when clicked.
+	info *cryptomaterial.Clickable
+}
+
+// queuedFeeStatus is a ticket status waiting to be fetched.
+type queuedFeeStatus struct {
+	hash   string
+	status *vspFeeStatus
+}
+
+// vspFeeStatuses caches the VSP fee status of tickets. The status of a ticket
+// is fetched in the background the first time it is requested since the VSP
+// may be contacted. At most maxVSPFeeFetches statuses are fetched at a time.
+type vspFeeStatuses struct {
+	mtx      sync.Mutex
+	statuses map[string]*vspFeeStatus
+	queue    []queuedFeeStatus
+	// workers is the number of goroutines fetching the queued statuses.
+	workers int
+
+	theme     *cryptomaterial.Theme
+	fetch     func(hash string) (*dcr.VSPTicketInfo, error)
+	onFetched func()
+}
+
+func newVSPFeeStatuses(theme *cryptomaterial.Theme, fetch func(hash string) (*dcr.VSPTicketInfo, error), onFetched func()) *vspFeeStatuses {
+	return &vspFeeStatuses{
+		statuses:  make(map[string]*vspFeeStatus),
+		theme:     theme,
+		fetch:     fetch,
+		onFetched: onFetched,
+	}
+}
+
+// status returns the status of the ticket. A status that isn't fetched yet is
+// returned until the background fetch completes.
+func (c *vspFeeStatuses) status(hash string) *vspFeeStatus {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if status, ok := c.statuses[hash]; ok {
+		return status
+	}
+
+	status := &vspFeeStatus{info: c.theme.NewClickable(false)}
+	c.statuses[hash] = status
+	c.queue = append(c.queue, queuedFeeStatus{hash: hash, status: status})
+	if c.workers < maxVSPFeeFetches {
+		c.workers++
+		go c.fetchQueued()
+	}
+	return status
+}
+
+// fetchQueued fetches the queued statuses until the queue is empty.
+func (c *vspFeeStatuses) fetchQueued() {
+	for {
+		c.mtx.Lock()
+		if len(c.queue) == 0 {
+			c.workers--
+			c.mtx.Unlock()
+			return
+		}
+		next := c.queue[0]
+		c.queue = c.queue[1:]
+		c.mtx.Unlock()
+
+		c.fetchStatus(next.hash, next.status)
+	}
+}
+
+func (c *vspFeeStatuses) fetchStatus(hash string, status *vspFeeStatus) {
+	info, err := c.fetch(hash)
+	if err != nil && err.Error() != libutils.ErrWalletLocked {
+		log.Errorf("VSPTicketInfo error: %v", err)
+	}
+
+	c.mtx.Lock()
+	status.fetched = true
+	if info != nil {
+		status.vsp = info.VSP
+		status.feeStatus = info.FeeTxStatus
+		// ConfirmedByVSP is only meaningful if the VSP was reached.
+		status.unconfirmed = info.FeeTxStatus != dcr.VSPFeeProcessConfirmed ||
+			info.Client != nil && !info.ConfirmedByVSP
+	}
+	unconfirmed := status.unconfirmed
+	c.mtx.Unlock()
+
+	if unconfirmed && c.onFetched != nil {
+		c.onFetched()
+	}
+}
+
+// warning returns the status of the ticket if its fee is unconfirmed, nil
+// otherwise.
+func (c *vspFeeStatuses) warning(hash string) *vspFeeStatus {
+	status := c.status(hash)
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !status.fetched || !status.unconfirmed {
+		return nil
+	}
+	return status
+}
+
+// clickedWarning returns the status of the ticket whose warning was clicked,
+// nil if none was clicked.
+func (c *vspFeeStatuses) clickedWarning(gtx C) *vspFeeStatus {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, status := range c.statuses {
+		if status.fetched && status.unconfirmed && status.info.Clicked(gtx) {
+			return status
+		}
+	}
+	return nil
+}
+
+// reset drops the cached statuses so they are fetched again. The statuses
+// queued for fetching are dropped as well.
+func (c *vspFeeStatuses) reset() {
+	c.mtx.Lock()
+	c.statuses = make(map[string]*vspFeeStatus)
+	c.queue = nil
+	c.mtx.Unlock()
+}
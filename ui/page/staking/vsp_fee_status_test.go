@@ -0,0 +1,113 @@
+package staking
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/ui/assets"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+)
+
+func TestVSPFeeStatusesCache(t *testing.T) {
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	release := make(chan struct{})
+	fetchedCh := make(chan struct{}, 1)
+	var fetches atomic.Int32
+	statuses := newVSPFeeStatuses(th, func(string) (*dcr.VSPTicketInfo, error) {
+		fetches.Add(1)
+		<-release
+		return &dcr.VSPTicketInfo{VSP: "https://vsp.test", FeeTxStatus: dcr.VSPFeeProcessPaid}, nil
+	}, func() { fetchedCh <- struct{}{} })
+
+	// The status isn't fetched again while its fetch is in flight.
+	first := statuses.status("ticket")
+	if second := statuses.status("ticket"); second != first {
+		t.Fatal("expected the in-flight status to be returned")
+	}
+	if statuses.warning("ticket") != nil {
+		t.Fatal("expected no warning before the status is fetched")
+	}
+
+	close(release)
+	select {
+	case <-fetchedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the status to be fetched")
+	}
+
+	warning := statuses.warning("ticket")
+	if warning == nil || warning.vsp != "https://vsp.test" {
+		t.Fatal("expected a warning for the unconfirmed fee")
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("expected a single fetch, got %d", n)
+	}
+
+	// The status is fetched again once reset.
+	statuses.reset()
+	statuses.status("ticket")
+	select {
+	case <-fetchedCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the status to be fetched again")
+	}
+	if n := fetches.Load(); n != 2 {
+		t.Fatalf("expected the status to be fetched again, got %d fetches", n)
+	}
+}
+
+func TestVSPFeeStatusesWorkerLimit(t *testing.T) {
+	th := cryptomaterial.NewTheme(assets.FontCollection(), assets.DecredIcons, false)
+	const tickets = 3 * maxVSPFeeFetches
+	release := make(chan struct{})
+	var mtx sync.Mutex
+	var running, maxRunning int
+	var done sync.WaitGroup
+	done.Add(tickets)
+	statuses := newVSPFeeStatuses(th, func(string) (*dcr.VSPTicketInfo, error) {
+		mtx.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mtx.Unlock()
+
+		<-release
+
+		mtx.Lock()
+		running--
+		mtx.Unlock()
+		return &dcr.VSPTicketInfo{FeeTxStatus: dcr.VSPFeeProcessPaid}, nil
+	}, done.Done)
+
+	for i := 0; i < tickets; i++ {
+		statuses.status(fmt.Sprintf("ticket%d", i))
+	}
+	// Give the workers time to start fetching.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	finished := make(chan struct{})
+	go func() {
+		done.Wait()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the statuses to be fetched")
+	}
+
+	if maxRunning != maxVSPFeeFetches {
+		t.Fatalf("expected at most %d fetches at a time, got %d", maxVSPFeeFetches, maxRunning)
+	}
+	for i := 0; i < tickets; i++ {
+		if statuses.warning(fmt.Sprintf("ticket%d", i)) == nil {
+			t.Fatalf("expected ticket%d to be fetched", i)
+		}
+	}
+}
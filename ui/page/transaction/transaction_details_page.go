@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"gioui.org/io/clipboard"
 	"gioui.org/layout"
@@ -40,6 +41,11 @@ type transactionWdg struct {
 	txStatus        *components.TxStatus
 }
 
+type txTagItem struct {
+	tag       string
+	clickable *cryptomaterial.Clickable
+}
+
 type moreItem struct {
 	text   string
 	id     string
@@ -62,6 +68,7 @@ type TxDetailsPage struct {
 	associatedTicketClickable *cryptomaterial.Clickable
 	hashClickable             *cryptomaterial.Clickable
 	rebroadcastClickable      *cryptomaterial.Clickable
+	editLabelClickable        *cryptomaterial.Clickable
 	moreOption                *cryptomaterial.Clickable
 	outputsCollapsible        *cryptomaterial.Collapsible
 	inputsCollapsible         *cryptomaterial.Collapsible
@@ -84,6 +91,10 @@ type TxDetailsPage struct {
 	moreItems  []moreItem
 	txnWidgets transactionWdg
 
+	txTagItems []txTagItem
+	txTag      string
+	txLabel    string
+
 	txSourceAccount, txDestinationAccount string
 	txDestinationAddresses                []string
 	title                                 string
@@ -126,6 +137,7 @@ func NewTransactionDetailsPage(l *load.Load, wallet sharedW.Asset, transaction *
 		wallet:                 wallet,
 		rebroadcast:            rebroadcast,
 		rebroadcastClickable:   l.Theme.NewClickable(true),
+		editLabelClickable:     l.Theme.NewClickable(true),
 		rebroadcastIcon:        l.Theme.Icons.Rebroadcast,
 		txDestinationAddresses: make([]string, 0),
 	}
@@ -137,6 +149,14 @@ func NewTransactionDetailsPage(l *load.Load, wallet sharedW.Asset, transaction *
 
 	pg.moreItems = pg.getMoreItem()
 
+	// The first item clears the tag.
+	for _, tag := range append([]string{""}, sharedW.TxTags...) {
+		pg.txTagItems = append(pg.txTagItems, txTagItem{
+			tag:       tag,
+			clickable: l.Theme.NewClickable(true),
+		})
+	}
+
 	return pg
 }
 
@@ -222,6 +242,9 @@ destinationAddrLoop:
 // the page is displayed.
 // Part of the load.Page interface.
 func (pg *TxDetailsPage) OnNavigatedTo() {
+	pg.txTag = pg.wallet.TxTag(pg.transaction.Hash)
+	pg.txLabel = components.TxLabel(pg.wallet, pg.transaction)
+
 	if dcrImp, ok := pg.wallet.(*dcr.Asset); ok {
 		// this tx is a vote transaction
 		if pg.transaction.TicketSpentHash != "" {
@@ -314,6 +337,8 @@ func (pg *TxDetailsPage) Layout(gtx C) D {
 					return
 				}
 				pg.transaction = pg.txBackStack
+				pg.txTag = pg.wallet.TxTag(pg.transaction.Hash)
+				pg.txLabel = components.TxLabel(pg.wallet, pg.transaction)
 				pg.getTXSourceAccountAndDirection()
 				pg.txnWidgets = pg.initTxnWidgets()
 				pg.txBackStack = nil
@@ -791,15 +816,97 @@ func (pg *TxDetailsPage) txnTypeAndID(gtx C) D {
 			return pg.keyValue(gtx, values.String(values.StrTransactionID), dim)
 		}),
 		layout.Rigid(func(gtx C) D {
-			if len(pg.transaction.Label) != 0 {
-				txlabel := pg.Theme.Label(values.TextSize14, pg.transaction.Label)
-				return pg.keyValue(gtx, values.String(values.StrDescriptionNote), txlabel.Layout)
-			}
-			return D{}
+			return pg.keyValue(gtx, values.String(values.StrDescriptionNote), pg.txLabelLayout)
+		}),
+		layout.Rigid(func(gtx C) D {
+			return pg.keyValue(gtx, values.String(values.StrTag), pg.txTagPickerLayout)
 		}),
 	)
 }
 
+// txLabelLayout draws the label of the transaction with an edit icon. A
+// placeholder is drawn if the transaction isn't labelled.
+func (pg *TxDetailsPage) txLabelLayout(gtx C) D {
+	return pg.editLabelClickable.Layout(gtx, func(gtx C) D {
+		return layout.Flex{Alignment: layout.Middle}.Layout(gtx,
+			layout.Rigid(func(gtx C) D {
+				lbl := pg.Theme.Label(values.TextSize14, pg.txLabel)
+				if pg.txLabel == "" {
+					lbl.Text = values.String(values.StrAddLabel)
+					lbl.Color = pg.Theme.Color.GrayText2
+				}
+				return lbl.Layout(gtx)
+			}),
+			layout.Rigid(func(gtx C) D {
+				return layout.Inset{Left: values.MarginPadding8}.Layout(gtx, pg.Theme.Icons.EditIcon.Layout16dp)
+			}),
+		)
+	})
+}
+
+// showEditLabelModal lets the user change or, by saving an empty label,
+// remove the label of the transaction.
+func (pg *TxDetailsPage) showEditLabelModal() {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrDescriptionNote)).
+		SetText(pg.txLabel).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(label string, tm *modal.TextInputModal) bool {
+			label = strings.TrimSpace(label)
+			if utf8.RuneCountInString(label) > sharedW.MaxTxLabelLength {
+				tm.SetError(values.StringF(values.StrTxLabelLengthError, sharedW.MaxTxLabelLength))
+				return false
+			}
+
+			if err := pg.wallet.SetTxLabel(pg.transaction.Hash, label); err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+			pg.txLabel = components.TxLabel(pg.wallet, pg.transaction)
+			return true
+		})
+	textModal.Title(values.String(values.StrEditTxLabel)).
+		SetPositiveButtonText(values.String(values.StrSave))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+// txTagPickerLayout draws the selectable transaction tags, highlighting the
+// tag currently set for the transaction.
+func (pg *TxDetailsPage) txTagPickerLayout(gtx C) D {
+	children := make([]layout.FlexChild, 0, len(pg.txTagItems))
+	for _, item := range pg.txTagItems {
+		item := item
+		children = append(children, layout.Rigid(func(gtx C) D {
+			txtColor, borderColor := pg.Theme.Color.GrayText2, pg.Theme.Color.Gray2
+			if item.tag == pg.txTag {
+				txtColor, borderColor = pg.Theme.Color.Primary, pg.Theme.Color.Primary
+			}
+			return cryptomaterial.LinearLayout{
+				Width:     cryptomaterial.WrapContent,
+				Height:    cryptomaterial.WrapContent,
+				Clickable: item.clickable,
+				Border: cryptomaterial.Border{
+					Radius: cryptomaterial.Radius(4),
+					Color:  borderColor,
+					Width:  values.MarginPadding1,
+				},
+				Margin: layout.Inset{Right: values.MarginPadding8},
+				Padding: layout.Inset{
+					Top:    values.MarginPadding3,
+					Bottom: values.MarginPadding3,
+					Left:   values.MarginPadding8,
+					Right:  values.MarginPadding8,
+				},
+			}.Layout2(gtx, func(gtx C) D {
+				lbl := pg.Theme.Label(values.TextSize14, components.TxTagDisplayName(item.tag))
+				lbl.Color = txtColor
+				return lbl.Layout(gtx)
+			})
+		}))
+	}
+	return layout.Flex{}.Layout(gtx, children...)
+}
+
 func (pg *TxDetailsPage) txnInputs(gtx C) D {
 	transaction := pg.transaction
 
@@ -951,6 +1058,20 @@ func (pg *TxDetailsPage) pageSections(gtx C, body layout.Widget) D {
 // displayed.
 // Part of the load.Page interface.
 func (pg *TxDetailsPage) HandleUserInteractions(gtx C) {
+	for _, item := range pg.txTagItems {
+		if item.clickable.Clicked(gtx) && item.tag != pg.txTag {
+			if err := pg.wallet.SetTxTag(pg.transaction.Hash, item.tag); err != nil {
+				pg.Toast.NotifyError(err.Error())
+				continue
+			}
+			pg.txTag = item.tag
+		}
+	}
+
+	if pg.editLabelClickable.Clicked(gtx) {
+		pg.showEditLabelModal()
+	}
+
 	for _, item := range pg.moreItems {
 		if item.button.Clicked(gtx) {
 			switch item.id {
@@ -970,6 +1091,8 @@ func (pg *TxDetailsPage) HandleUserInteractions(gtx C) {
 		if pg.ticketSpent != nil {
 			pg.txBackStack = pg.transaction
 			pg.transaction = pg.ticketSpent
+			pg.txTag = pg.wallet.TxTag(pg.transaction.Hash)
+			pg.txLabel = components.TxLabel(pg.wallet, pg.transaction)
 			pg.getTXSourceAccountAndDirection()
 			pg.txnWidgets = pg.initTxnWidgets()
 			pg.ParentWindow().Reload()
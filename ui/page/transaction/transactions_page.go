@@ -66,6 +66,7 @@ type TransactionsPage struct {
 
 	statusDropDown *cryptomaterial.DropDown
 	orderDropDown  *cryptomaterial.DropDown
+	tagDropDown    *cryptomaterial.DropDown
 	walletDropDown *cryptomaterial.DropDown
 	filterBtn      *cryptomaterial.Clickable
 	exportBtn      *cryptomaterial.Clickable
@@ -128,9 +129,30 @@ func NewTransactionsPage(l *load.Load, wallet sharedW.Asset) *TransactionsPage {
 	settingCommonDropdown(pg.Theme, pg.orderDropDown)
 	pg.orderDropDown.SetConvertTextSize(pg.ConvertTextSize)
 
+	tagItems := []cryptomaterial.DropDownItem{{Text: values.String(values.StrAllTags)}}
+	for _, tag := range sharedW.TxTags {
+		tagItems = append(tagItems, cryptomaterial.DropDownItem{Text: components.TxTagDisplayName(tag)})
+	}
+	pg.tagDropDown = l.Theme.DropdownWithCustomPos(tagItems, values.TxDropdownGroup, 1, 1, false)
+	pg.tagDropDown.Width = values.DP118
+	pg.tagDropDown.CollapsedLayoutTextDirection = layout.E
+	settingCommonDropdown(pg.Theme, pg.tagDropDown)
+	pg.tagDropDown.SetConvertTextSize(pg.ConvertTextSize)
+
 	return pg
 }
 
+// selectedTxTag returns the tag used to filter the displayed transactions or
+// an empty string if transactions are not filtered by tag.
+func (pg *TransactionsPage) selectedTxTag() string {
+	index := pg.tagDropDown.SelectedIndex()
+	// The "All tags" dropdown item is the first in the dropdown list.
+	if index <= 0 || index > len(sharedW.TxTags) {
+		return ""
+	}
+	return sharedW.TxTags[index-1]
+}
+
 func (pg *TransactionsPage) DisableUniformTab() {
 	pg.txCategoryTab.DisableUniform(true)
 }
@@ -303,10 +325,21 @@ func (pg *TransactionsPage) loadTransactions(wal sharedW.Asset, offset, pageSize
 	}
 	pg.txFilter = txFilter
 	searchKey := pg.searchEditor.Editor.Text()
-	walletTxs, err := wal.GetTransactionsRaw(offset, pageSize, txFilter, newestFirst, searchKey)
+	var walletTxs []*sharedW.Transaction
+	var err error
+	if tag := pg.selectedTxTag(); tag != "" {
+		// Tags are not indexed with the txs, filter all the matching txs
+		// and then paginate the result.
+		walletTxs, err = wal.GetTransactionsRaw(0, math.MaxInt32, txFilter, newestFirst, searchKey)
+		walletTxs = sharedW.FilterTxsByTag(walletTxs, wal.TxTagsMap(), tag)
+		walletTxs = walletTxs[min(int(offset), len(walletTxs)):min(int(offset+pageSize), len(walletTxs))]
+	} else {
+		walletTxs, err = wal.GetTransactionsRaw(offset, pageSize, txFilter, newestFirst, searchKey)
+	}
 	if err != nil {
 		err = fmt.Errorf("error loading transactions: %v", err)
 	}
+	sharedW.ApplyTxLabels(walletTxs, wal.TxLabelsMap())
 
 	txs := make([]*multiWalletTx, 0)
 	for i := range walletTxs {
@@ -451,6 +484,7 @@ func (pg *TransactionsPage) rightDropdown(gtx C) D {
 	return layout.E.Layout(gtx, func(gtx C) D {
 		return layout.Flex{}.Layout(gtx,
 			layout.Rigid(pg.statusDropDown.Layout),
+			layout.Rigid(pg.tagDropDown.Layout),
 			layout.Rigid(pg.orderDropDown.Layout),
 		)
 	})
@@ -569,6 +603,10 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 		go pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
 	}
 
+	if pg.tagDropDown.Changed(gtx) {
+		go pg.scroll.FetchScrollData(false, pg.ParentWindow(), true)
+	}
+
 	if pg.walletDropDown != nil && pg.walletDropDown.Changed(gtx) {
 		assetIndex := pg.walletDropDown.SelectedIndex()
 		// The "All Wallets" dropdown item is the first in the dropdown list.
@@ -590,7 +628,7 @@ func (pg *TransactionsPage) HandleUserInteractions(gtx C) {
 		pg.ParentNavigator().Display(NewTransactionDetailsPage(pg.Load, wal, tx))
 	}
 
-	dropDownList := []*cryptomaterial.DropDown{pg.statusDropDown}
+	dropDownList := []*cryptomaterial.DropDown{pg.statusDropDown, pg.tagDropDown}
 	if pg.walletDropDown != nil {
 		dropDownList = append(dropDownList, pg.walletDropDown)
 	}
@@ -679,7 +717,7 @@ func exportTxs(assets []sharedW.Asset, fileName string) error {
 	}
 	defer f.Close()
 
-	headers := []string{values.String(values.StrTime), values.String(values.StrHash), values.String(values.StrType), values.String(values.StrDirection), values.String(values.StrFee), values.String(values.StrAmount)}
+	headers := []string{values.String(values.StrTime), values.String(values.StrHash), values.String(values.StrType), values.String(values.StrDirection), values.String(values.StrFee), values.String(values.StrAmount), values.String(values.StrTag)}
 
 	writer := csv.NewWriter(f)
 	writer.UseCRLF = runtime.GOOS == "windows"
@@ -693,6 +731,7 @@ func exportTxs(assets []sharedW.Asset, fileName string) error {
 		if err != nil {
 			return fmt.Errorf("wallet.GetTransactionsRaw error: %w", err)
 		}
+		tags := a.TxTagsMap()
 
 		// Write txs to file.
 		for _, tx := range txs {
@@ -703,6 +742,7 @@ func exportTxs(assets []sharedW.Asset, fileName string) error {
 				txhelper.TxDirectionString(tx.Direction),
 				a.ToAmount(tx.Fee).String(),
 				a.ToAmount(tx.Amount).String(),
+				tags[tx.Hash],
 			})
 			if err != nil {
 				return fmt.Errorf("csv.Writer.Write error: %v", err)
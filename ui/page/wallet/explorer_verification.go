@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/crypto-power/cryptopower/libwallet/explorer"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// explorerLookupTimeout bounds the time spent waiting for the block explorer.
+const explorerLookupTimeout = 30 * time.Second
+
+// verifyWithExplorerModal lets the user confirm that a wallet that isn't synced
+// yet owns funded addresses by looking them up on a block explorer. The user
+// is warned that the address is shared with an external service first.
+func (pg *SettingsPage) verifyWithExplorerModal() {
+	client, err := pg.AssetsManager.ExplorerClient(pg.wallet.GetAssetType())
+	if err != nil {
+		errModal := modal.NewErrorModal(pg.Load, values.String(values.StrExplorerAPIDisabled), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	warningModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrVerifyWithExplorer)).
+		Body(values.StringF(values.StrExplorerPrivacyWarning, client.Host())).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrContinue)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			pg.explorerAddressModal(client)
+			return true
+		})
+	pg.ParentWindow().ShowModal(warningModal)
+}
+
+func (pg *SettingsPage) explorerAddressModal(client explorer.ExplorerClient) {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(values.String(values.StrAddress)).
+		ShowLoader(true).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(address string, tm *modal.TextInputModal) bool {
+			address = strings.TrimSpace(address)
+			if !pg.wallet.IsAddressValid(address) {
+				tm.SetError(values.String(values.StrInvalidAddress))
+				return false
+			}
+			if !pg.wallet.HaveAddress(address) {
+				tm.SetError(values.String(values.StrAddressNotInWallet))
+				return false
+			}
+
+			// The modal runs this callback off the UI thread and shows its
+			// loader until it returns. The timeout bounds how long that is.
+			ctx, cancel := context.WithTimeout(context.Background(), explorerLookupTimeout)
+			defer cancel()
+			history, err := explorer.VerifyAddresses(ctx, client, address)
+			if err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+
+			pg.showExplorerResult(address, history)
+			return true
+		})
+
+	// Suggest the current receive address, it's the one most likely to have
+	// been shared and funded.
+	if address, err := pg.wallet.CurrentAddress(0); err == nil {
+		textModal.SetText(address)
+	}
+
+	textModal.Title(values.String(values.StrVerifyWithExplorer)).
+		SetPositiveButtonText(values.String(values.StrConfirm)).
+		SetNegativeButtonText(values.String(values.StrCancel))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
+func (pg *SettingsPage) showExplorerResult(address string, history *explorer.AddressHistory) {
+	if history == nil {
+		info := modal.NewCustomModal(pg.Load).
+			Title(values.String(values.StrVerifyWithExplorer)).
+			Body(values.StringF(values.StrAddressHasNoHistory, address)).
+			SetPositiveButtonText(values.String(values.StrOk))
+		pg.ParentWindow().ShowModal(info)
+		return
+	}
+
+	received := pg.wallet.ToAmount(history.TotalReceived).String()
+	info := modal.NewSuccessModal(pg.Load, values.StringF(values.StrAddressHasHistory, address, history.TxCount, received), modal.DefaultClickFunc())
+	pg.ParentWindow().ShowModal(info)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui/page/send"
 	"github.com/crypto-power/cryptopower/ui/page/staking"
 	"github.com/crypto-power/cryptopower/ui/page/transaction"
+	"github.com/crypto-power/cryptopower/ui/preference"
 	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 	"github.com/gen2brain/beeep"
@@ -706,16 +707,22 @@ func (swmp *SingleWalletMasterPage) postTransactionNotification(t *sharedW.Trans
 	wal := swmp.selectedWallet
 	switch t.Type {
 	case dcr.TxTypeRegular:
-		if t.Direction != dcr.TxDirectionReceived {
+		if t.Direction != dcr.TxDirectionReceived || !preference.WalletNotificationOn(wal, preference.TxReceivedNotification) {
 			return
 		}
 		// remove trailing zeros from amount and convert to string
 		amount := strconv.FormatFloat(wal.ToAmount(t.Amount).ToCoin(), 'f', -1, 64)
 		notification = values.StringF(values.StrDcrReceived, amount)
 	case dcr.TxTypeVote:
+		if !preference.WalletNotificationOn(wal, preference.TicketVotedNotification) {
+			return
+		}
 		reward := strconv.FormatFloat(wal.ToAmount(t.VoteReward).ToCoin(), 'f', -1, 64)
 		notification = values.StringF(values.StrTicketVoted, reward)
 	case dcr.TxTypeRevocation:
+		if !preference.WalletNotificationOn(wal, preference.TicketRevokedNotification) {
+			return
+		}
 		notification = values.String(values.StrTicketRevoked)
 	default:
 		return
@@ -793,8 +800,7 @@ func (swmp *SingleWalletMasterPage) listenForNotifications() {
 		// called, so use OnBlockAttached. Also, OnTransactionConfirmed may be
 		// called multiple times whereas OnBlockAttached is only called once.
 		OnBlockAttached: func(_ int, _ int32) {
-			beep := swmp.selectedWallet.ReadBoolConfigValueForKey(sharedW.BeepNewBlocksConfigKey, false)
-			if beep {
+			if preference.WalletNotificationOn(swmp.selectedWallet, preference.NewBlockNotification) {
 				err := beeep.Beep(5, 1)
 				if err != nil {
 					log.Error(err.Error)
@@ -0,0 +1,127 @@
+package wallet
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"gioui.org/layout"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+	"github.com/crypto-power/cryptopower/ui/modal"
+	"github.com/crypto-power/cryptopower/ui/values"
+)
+
+// integrityOperation is a wallet integrity check or repair that reports its
+// progress as a percentage.
+type integrityOperation func(ctx context.Context, progress sharedW.IntegrityProgressFn) error
+
+// verifyIntegrityModal checks the wallet data in the background and displays
+// the issues found, offering to repair them if they are recoverable.
+func (pg *SettingsPage) verifyIntegrityModal() {
+	if pg.wallet.IsConnectedToNetwork() {
+		errModal := modal.NewErrorModal(pg.Load, values.String(values.StrDisconnectToVerify), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(errModal)
+		return
+	}
+
+	var report *sharedW.IntegrityReport
+	verify := func(ctx context.Context, progress sharedW.IntegrityProgressFn) (err error) {
+		report, err = pg.wallet.VerifyIntegrity(ctx, progress)
+		return err
+	}
+
+	pg.runIntegrityOperation(values.StrVerifyingIntegrity, verify, func(err error) {
+		switch {
+		case err != nil:
+			errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(errModal)
+		case !report.HasIssues():
+			info := modal.NewSuccessModal(pg.Load, values.String(values.StrIntegrityOK), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(info)
+		default:
+			pg.integrityIssuesModal(report)
+		}
+	})
+}
+
+func (pg *SettingsPage) integrityIssuesModal(report *sharedW.IntegrityReport) {
+	issues := make([]string, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, "• "+issue.Description)
+	}
+
+	info := modal.NewErrorModal(pg.Load, values.String(values.StrIntegrityIssuesFound), modal.DefaultClickFunc())
+	if !report.IsRecoverable() {
+		info.Body(strings.Join(append(issues, "", values.String(values.StrIntegrityNotRepairable)), "\n"))
+		pg.ParentWindow().ShowModal(info)
+		return
+	}
+
+	info.Body(strings.Join(append(issues, "", values.String(values.StrIntegrityRepairable)), "\n")).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetPositiveButtonText(values.String(values.StrRepair)).
+		SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+			pg.repairIntegrity(report)
+			return true
+		})
+	pg.ParentWindow().ShowModal(info)
+}
+
+func (pg *SettingsPage) repairIntegrity(report *sharedW.IntegrityReport) {
+	repair := func(ctx context.Context, progress sharedW.IntegrityProgressFn) error {
+		return pg.wallet.RepairIntegrity(ctx, report, progress)
+	}
+
+	pg.runIntegrityOperation(values.StrRepairingIntegrity, repair, func(err error) {
+		if err != nil {
+			errModal := modal.NewErrorModal(pg.Load, err.Error(), modal.DefaultClickFunc())
+			pg.ParentWindow().ShowModal(errModal)
+			return
+		}
+		info := modal.NewSuccessModal(pg.Load, values.String(values.StrIntegrityRepaired), modal.DefaultClickFunc())
+		pg.ParentWindow().ShowModal(info)
+	})
+}
+
+// runIntegrityOperation runs op off the UI thread while a modal displays its
+// progress. done is called with the result of op unless the user cancels the
+// operation by dismissing the modal.
+func (pg *SettingsPage) runIntegrityOperation(progressTextKey string, op integrityOperation, done func(err error)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var percent atomic.Int32
+
+	progressModal := modal.NewCustomModal(pg.Load).
+		Title(values.String(values.StrVerifyIntegrity)).
+		UseCustomWidget(func(gtx C) D {
+			progress := percent.Load()
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(pg.Theme.Body1(values.StringF(progressTextKey, progress)).Layout),
+				layout.Rigid(func(gtx C) D {
+					p := pg.Theme.ProgressBar(int(progress))
+					p.Height = values.MarginPadding8
+					p.Radius = cryptomaterial.Radius(4)
+					return layout.Inset{Top: values.MarginPadding8}.Layout(gtx, p.Layout)
+				}),
+			)
+		}).
+		SetNegativeButtonText(values.String(values.StrCancel)).
+		SetNegativeButtonCallback(cancel).
+		SetPositiveButtonText("")
+	pg.ParentWindow().ShowModal(progressModal)
+
+	go func() {
+		err := op(ctx, func(progress int32) {
+			percent.Store(progress)
+			pg.ParentWindow().Reload()
+		})
+		if ctx.Err() != nil {
+			// Canceled by the user, the modal is already dismissed.
+			return
+		}
+		cancel()
+		progressModal.Dismiss()
+		done(err)
+	}()
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/crypto-power/cryptopower/ui/page/security"
 	"github.com/crypto-power/cryptopower/ui/page/seedbackup"
 	s "github.com/crypto-power/cryptopower/ui/page/settings"
+	"github.com/crypto-power/cryptopower/ui/preference"
 	"github.com/crypto-power/cryptopower/ui/utils"
 	"github.com/crypto-power/cryptopower/ui/values"
 )
@@ -54,11 +55,13 @@ type SettingsPage struct {
 
 	pageContainer *widget.List
 
-	changePass, viewSeed, rescan               *cryptomaterial.Clickable
-	changeAccount, checklog, checkStats        *cryptomaterial.Clickable
-	changeWalletName, addAccount, deleteWallet *cryptomaterial.Clickable
-	verifyMessage, validateAddr, signMessage   *cryptomaterial.Clickable
-	updateConnectToPeer, setGapLimit           *cryptomaterial.Clickable
+	changePass, viewSeed, rescan, verifyIntegrity *cryptomaterial.Clickable
+	verifyWithExplorer                            *cryptomaterial.Clickable
+	changeAccount, checklog, checkStats           *cryptomaterial.Clickable
+	changeWalletName, addAccount, deleteWallet    *cryptomaterial.Clickable
+	verifyMessage, validateAddr, signMessage      *cryptomaterial.Clickable
+	updateConnectToPeer, setGapLimit              *cryptomaterial.Clickable
+	setMinFeeRate, notifications                  *cryptomaterial.Clickable
 
 	backButton cryptomaterial.IconButton
 	infoButton cryptomaterial.IconButton
@@ -66,11 +69,13 @@ type SettingsPage struct {
 	spendUnconfirmed  *cryptomaterial.Switch
 	spendUnmixedFunds *cryptomaterial.Switch
 	connectToPeer     *cryptomaterial.Switch
+	hideWallet        *cryptomaterial.Switch
 
 	walletCallbackFunc func()
 	changeTab          func(string)
 
-	peerAddr string
+	peerAddr   string
+	minFeeRate string
 }
 
 func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func(), changeTab func(string)) *SettingsPage {
@@ -81,6 +86,8 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 		changePass:          l.Theme.NewClickable(false),
 		viewSeed:            l.Theme.NewClickable(false),
 		rescan:              l.Theme.NewClickable(false),
+		verifyIntegrity:     l.Theme.NewClickable(false),
+		verifyWithExplorer:  l.Theme.NewClickable(false),
 		setGapLimit:         l.Theme.NewClickable(false),
 		changeAccount:       l.Theme.NewClickable(false),
 		checklog:            l.Theme.NewClickable(false),
@@ -92,10 +99,13 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 		validateAddr:        l.Theme.NewClickable(false),
 		signMessage:         l.Theme.NewClickable(false),
 		updateConnectToPeer: l.Theme.NewClickable(false),
+		setMinFeeRate:       l.Theme.NewClickable(false),
+		notifications:       l.Theme.NewClickable(false),
 
 		spendUnconfirmed:  l.Theme.Switch(),
 		spendUnmixedFunds: l.Theme.Switch(),
 		connectToPeer:     l.Theme.Switch(),
+		hideWallet:        l.Theme.Switch(),
 
 		pageContainer: &widget.List{
 			List: layout.List{Axis: layout.Vertical},
@@ -117,8 +127,10 @@ func NewSettingsPage(l *load.Load, wallet sharedW.Asset, walletCallbackFunc func
 func (pg *SettingsPage) OnNavigatedTo() {
 	pg.spendUnconfirmed.SetChecked(pg.readBool(sharedW.SpendUnconfirmedConfigKey))
 	pg.spendUnmixedFunds.SetChecked(pg.readBool(sharedW.SpendUnmixedFundsKey))
+	pg.hideWallet.SetChecked(pg.wallet.IsHidden())
 
 	pg.loadPeerAddress()
+	pg.loadMinFeeRate()
 
 	pg.loadWalletAccount()
 }
@@ -138,6 +150,22 @@ func (pg *SettingsPage) loadPeerAddress() {
 	}
 }
 
+// loadMinFeeRate reads the minimum fee rate of wallets that support it, it is
+// left empty for the other wallets.
+func (pg *SettingsPage) loadMinFeeRate() {
+	pg.minFeeRate = ""
+	if minFeeRate, err := load.GetMinFeeRate(pg.wallet); err == nil {
+		pg.minFeeRate = strconv.FormatInt(minFeeRate, 10)
+	}
+}
+
+func (pg *SettingsPage) feeRateUnit() string {
+	if pg.wallet.GetAssetType() == libutils.LTCWalletAsset {
+		return "Lit/kvB"
+	}
+	return "Sat/kvB"
+}
+
 func (pg *SettingsPage) loadWalletAccount() {
 	walletAccounts := make([]*accountData, 0)
 	accounts, err := pg.wallet.GetAccountsRaw()
@@ -203,6 +231,27 @@ func (pg *SettingsPage) generalSection() layout.Widget {
 				return D{}
 			}),
 			layout.Rigid(func(gtx C) D {
+				return pg.subSection(gtx, values.String(values.StrHideFromWalletSelectors), pg.hideWallet.Layout)
+			}),
+			layout.Rigid(pg.sectionContent(pg.notifications, values.String(values.StrNotifications))),
+			layout.Rigid(func(gtx C) D {
+				if pg.minFeeRate == "" {
+					return D{}
+				}
+
+				minFeeRateRow := clickableRowData{
+					title:     values.StringF(values.StrMinFeeRate, ""),
+					clickable: pg.setMinFeeRate,
+					labelText: fmt.Sprintf("%s %s", pg.minFeeRate, pg.feeRateUnit()),
+				}
+				return pg.clickableRow(gtx, minFeeRateRow)
+			}),
+			layout.Rigid(func(gtx C) D {
+				// A configured peer stays visible so that it can be changed
+				// or removed with the advanced mode off.
+				if !pg.IsAdvancedModeOn() && pg.peerAddr == "" {
+					return D{}
+				}
 				return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 					layout.Rigid(pg.subSectionSwitch(values.String(values.StrConnectToSpecificPeer), pg.connectToPeer)),
 					layout.Rigid(func(gtx C) D {
@@ -231,8 +280,16 @@ func (pg *SettingsPage) debug() layout.Widget {
 	dim := func(gtx C) D {
 		return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
 			layout.Rigid(pg.sectionContent(pg.rescan, values.String(values.StrRescanBlockchain))),
+			layout.Rigid(pg.sectionContent(pg.verifyIntegrity, values.String(values.StrVerifyIntegrity))),
 			layout.Rigid(func(gtx C) D {
-				if pg.wallet.GetAssetType() == libutils.DCRWalletAsset {
+				// Restores can be confirmed locally once the wallet is synced.
+				if pg.wallet.IsSynced() {
+					return D{}
+				}
+				return pg.sectionDimension(gtx, pg.verifyWithExplorer, values.String(values.StrVerifyWithExplorer))
+			}),
+			layout.Rigid(func(gtx C) D {
+				if pg.wallet.GetAssetType() == libutils.DCRWalletAsset && pg.IsAdvancedModeOn() {
 					return pg.sectionDimension(gtx, pg.setGapLimit, values.String(values.StrSetGapLimit))
 				}
 				return D{}
@@ -692,10 +749,32 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 		}()
 	}
 
+	if pg.verifyIntegrity.Clicked(gtx) {
+		pg.verifyIntegrityModal()
+	}
+
+	if pg.verifyWithExplorer.Clicked(gtx) {
+		pg.verifyWithExplorerModal()
+	}
+
 	if pg.setGapLimit.Clicked(gtx) {
 		pg.gapLimitModal()
 	}
 
+	if pg.setMinFeeRate.Clicked(gtx) {
+		pg.minFeeRateModal()
+	}
+
+	if pg.notifications.Clicked(gtx) {
+		notificationsModal := preference.NewMultiSelectListPreference(pg.Load, pg.wallet,
+			sharedW.WalletNotificationsConfigKey, preference.DefaultWalletNotifications,
+			preference.WalletNotificationOptions(pg.wallet.GetAssetType())).
+			ItemDescriptions(preference.WalletNotificationDescriptions).
+			Title(values.StrNotifications).
+			UpdateValues(func(_ string) {})
+		pg.ParentWindow().ShowModal(notificationsModal)
+	}
+
 	if pg.deleteWallet.Clicked(gtx) {
 		pg.deleteWalletModal()
 	}
@@ -713,6 +792,10 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 		pg.ParentWindow().ShowModal(info)
 	}
 
+	if pg.hideWallet.Changed(gtx) {
+		pg.wallet.SetHidden(pg.hideWallet.IsChecked())
+	}
+
 	if pg.spendUnconfirmed.Changed(gtx) {
 		pg.wallet.SaveUserConfigValue(sharedW.SpendUnconfirmedConfigKey, pg.spendUnconfirmed.IsChecked())
 	}
@@ -795,6 +878,10 @@ func (pg *SettingsPage) HandleUserInteractions(gtx C) {
 			EnableConfirmPassword(false).
 			PasswordHint(values.String(values.StrSpendingPassword)).
 			SetPositiveButtonCallback(func(accountName, password string, m *modal.CreatePasswordModal) bool {
+				if utils.IsAccountNameTaken(pg.wallet, accountName) {
+					m.SetError(values.String(values.StrAccountNameTaken))
+					return false
+				}
 				_, err := pg.wallet.CreateNewAccount(accountName, password)
 				if err != nil {
 					m.SetError(err.Error())
@@ -850,6 +937,24 @@ func (pg *SettingsPage) gapLimitModal() {
 	pg.ParentWindow().ShowModal(textModal)
 }
 
+func (pg *SettingsPage) minFeeRateModal() {
+	textModal := modal.NewTextInputModal(pg.Load).
+		Hint(pg.feeRateUnit()).
+		SetText(pg.minFeeRate).
+		PositiveButtonStyle(pg.Load.Theme.Color.Primary, pg.Load.Theme.Color.InvText).
+		SetPositiveButtonCallback(func(feeRate string, tm *modal.TextInputModal) bool {
+			if _, err := load.SetMinFeeRate(pg.wallet, strings.TrimSpace(feeRate)); err != nil {
+				tm.SetError(err.Error())
+				return false
+			}
+			pg.loadMinFeeRate()
+			return true
+		})
+	textModal.Title(values.StringF(values.StrMinFeeRate, "")).
+		SetPositiveButtonText(values.String(values.StrSave))
+	pg.ParentWindow().ShowModal(textModal)
+}
+
 // OnNavigatedFrom is called when the page is about to be removed from
 // the displayed window. This method should ideally be used to disable
 // features that are irrelevant when the page is NOT displayed.
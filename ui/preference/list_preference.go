@@ -3,10 +3,12 @@ package preference
 import (
 	"io"
 	"strings"
+	"sync/atomic"
 
 	"gioui.org/font"
 	"gioui.org/io/clipboard"
 	"gioui.org/layout"
+	"gioui.org/unit"
 	"gioui.org/widget"
 
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
@@ -18,6 +20,28 @@ import (
 	"github.com/crypto-power/cryptopower/ui/values/localizable"
 )
 
+// multiSelectDelimiter separates the keys of the options selected in a
+// multi-select list preference when they are persisted.
+const multiSelectDelimiter = ","
+
+// maxOptionsListHeight is the height beyond which the options scroll, keeping
+// the save and cancel buttons in view.
+const maxOptionsListHeight = unit.Dp(350)
+
+// Keys of the notifications that can be enabled for a wallet.
+const (
+	TxReceivedNotification    = "tx_received"
+	TicketVotedNotification   = "ticket_voted"
+	TicketRevokedNotification = "ticket_revoked"
+	NewBlockNotification      = "new_block"
+)
+
+// DefaultWalletNotifications are the notifications enabled for wallets that
+// haven't configured them.
+var DefaultWalletNotifications = strings.Join([]string{
+	TxReceivedNotification, TicketVotedNotification, TicketRevokedNotification,
+}, multiSelectDelimiter)
+
 const (
 	binanceProhibitedCountries = "https://www.binance.com/en/legal/list-of-prohibited-countries"
 	bittrexProhibitedCountries = "https://bittrex.zendesk.com/hc/en-us/articles/360034965072-Important-information-for-Bittrex-customers"
@@ -61,13 +85,55 @@ var (
 		{Key: libutils.LogLevelError, Value: values.StrLogLevelError},
 		{Key: libutils.LogLevelCritical, Value: values.StrLogLevelCritical},
 	}
+
+	// WalletNotificationDescriptions explain the wallet notifications.
+	WalletNotificationDescriptions = map[string]string{
+		TxReceivedNotification:    values.StrTxReceivedNotificationDesc,
+		TicketVotedNotification:   values.StrTicketVotedNotificationDesc,
+		TicketRevokedNotification: values.StrTicketRevokedNotificationDesc,
+		NewBlockNotification:      values.StrNewBlockNotificationDesc,
+	}
 )
 
+// WalletNotificationOptions returns the notifications that can be enabled for
+// wallets of the provided asset type.
+func WalletNotificationOptions(assetType libutils.AssetType) []ItemPreference {
+	options := []ItemPreference{{Key: TxReceivedNotification, Value: values.StrReceived}}
+	if assetType == libutils.DCRWalletAsset {
+		options = append(options,
+			ItemPreference{Key: TicketVotedNotification, Value: values.StrVoted},
+			ItemPreference{Key: TicketRevokedNotification, Value: values.StrRevoked},
+		)
+	}
+	return append(options, ItemPreference{Key: NewBlockNotification, Value: values.StrBeepForNewBlocks})
+}
+
+// WalletNotificationOn returns true if the notification identified by key is
+// enabled for the wallet.
+func WalletNotificationOn(wallet sharedW.Asset, key string) bool {
+	value := wallet.ReadStringConfigValueForKey(sharedW.WalletNotificationsConfigKey, DefaultWalletNotifications)
+	for _, enabled := range SplitMultiSelectValue(value) {
+		if enabled == key {
+			return true
+		}
+	}
+	return false
+}
+
 type ListPreferenceModal struct {
 	*load.Load
 	*cryptomaterial.Modal
 
 	optionsRadioGroup *widget.Enum
+	optionsList       *widget.List
+	searchable        bool
+	searchEditor      cryptomaterial.Editor
+	// multiSelect is true if options are selected with checkboxes and more
+	// than one option may be selected.
+	multiSelect    bool
+	optionsChecked map[string]*widget.Bool
+	// wallet holds the config the multi-select preference is persisted to.
+	wallet sharedW.Asset
 
 	btnSave      cryptomaterial.Button
 	btnCancel    cryptomaterial.Button
@@ -80,8 +146,12 @@ type ListPreferenceModal struct {
 	currentValue    string
 	isWalletAccount bool
 	preferenceItems []ItemPreference
+	// descriptions holds optional subtitles shown under the options, keyed
+	// by the option's key.
+	descriptions map[string]string
 
 	updateButtonClicked func(string)
+	isSaved             atomic.Bool
 
 	// use for warning link
 	viewWarningAction *cryptomaterial.Clickable
@@ -111,6 +181,7 @@ func NewListPreference(l *load.Load, preferenceKey, defaultValue string, items [
 
 		preferenceItems:   items,
 		optionsRadioGroup: new(widget.Enum),
+		optionsList:       &widget.List{List: layout.List{Axis: layout.Vertical}},
 		Modal:             l.Theme.ModalFloatTitle("list_preference", l.IsMobileView(), nil),
 		redirectIcon:      l.Theme.Icons.RedirectIcon,
 		viewWarningAction: l.Theme.NewClickable(true),
@@ -120,10 +191,56 @@ func NewListPreference(l *load.Load, preferenceKey, defaultValue string, items [
 	lp.btnSave.Font.Weight = font.Medium
 	lp.btnCancel.Font.Weight = font.Medium
 
+	lp.searchEditor = l.Theme.SearchEditor(new(widget.Editor), values.String(values.StrSearch), l.Theme.Icons.SearchIcon)
+	lp.searchEditor.Editor.SingleLine = true
+
 	return &lp
 }
 
+// NewMultiSelectListPreference returns a list preference modal that shows the
+// items as checkboxes, allowing any number of them to be selected. The keys
+// of the selected items are persisted to the wallet's preference key as a
+// delimited string, defaultValue should be formatted the same way.
+func NewMultiSelectListPreference(l *load.Load, wallet sharedW.Asset, preferenceKey, defaultValue string, items []ItemPreference) *ListPreferenceModal {
+	lp := NewListPreference(l, preferenceKey, defaultValue, items)
+	lp.multiSelect = true
+	lp.wallet = wallet
+	lp.optionsChecked = make(map[string]*widget.Bool, len(items))
+	for _, item := range items {
+		lp.optionsChecked[item.Key] = new(widget.Bool)
+	}
+	return lp
+}
+
+// SplitMultiSelectValue returns the keys in a value saved by a multi-select
+// list preference.
+func SplitMultiSelectValue(value string) []string {
+	var keys []string
+	for _, key := range strings.Split(value, multiSelectDelimiter) {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// checkedValue returns the keys of the checked items in the order they are
+// listed, joined into a single delimited string.
+func (lp *ListPreferenceModal) checkedValue() string {
+	keys := make([]string, 0, len(lp.preferenceItems))
+	for _, item := range lp.preferenceItems {
+		if lp.optionsChecked[item.Key].Value {
+			keys = append(keys, item.Key)
+		}
+	}
+	return strings.Join(keys, multiSelectDelimiter)
+}
+
 func (lp *ListPreferenceModal) ReadPreferenceKeyedValue() string {
+	if lp.multiSelect {
+		return lp.wallet.ReadStringConfigValueForKey(lp.preferenceKey, lp.defaultValue)
+	}
+
 	switch lp.preferenceKey {
 	case sharedW.CurrencyConversionConfigKey:
 		return lp.AssetsManager.GetCurrencyConversionExchange()
@@ -137,7 +254,12 @@ func (lp *ListPreferenceModal) ReadPreferenceKeyedValue() string {
 }
 
 func (lp *ListPreferenceModal) SavePreferenceKeyedValue() {
-	val := lp.optionsRadioGroup.Value
+	val := lp.currentValue
+	if lp.multiSelect {
+		lp.wallet.SaveUserConfigValue(lp.preferenceKey, val)
+		return
+	}
+
 	switch lp.preferenceKey {
 	case sharedW.CurrencyConversionConfigKey:
 		lp.AssetsManager.SetCurrencyConversionExchange(val)
@@ -152,7 +274,8 @@ func (lp *ListPreferenceModal) SavePreferenceKeyedValue() {
 
 func (lp *ListPreferenceModal) OnResume() {
 	initialValue := lp.ReadPreferenceKeyedValue()
-	if initialValue == "" {
+	// An empty multi-select value means no option is selected.
+	if initialValue == "" && !lp.multiSelect {
 		initialValue = lp.defaultValue
 	}
 
@@ -160,6 +283,34 @@ func (lp *ListPreferenceModal) OnResume() {
 	lp.currentValue = initialValue
 
 	lp.optionsRadioGroup.Value = lp.currentValue
+
+	if lp.multiSelect {
+		for _, checked := range lp.optionsChecked {
+			checked.Value = false
+		}
+		for _, key := range SplitMultiSelectValue(lp.currentValue) {
+			if checked, ok := lp.optionsChecked[key]; ok {
+				checked.Value = true
+			}
+		}
+	}
+
+	lp.searchEditor.Editor.SetText("")
+	lp.optionsList.ScrollTo(lp.selectedIndex())
+}
+
+// selectedIndex returns the index of the selected option, or of the first
+// checked option for multi-select preferences.
+func (lp *ListPreferenceModal) selectedIndex() int {
+	for i, item := range lp.preferenceItems {
+		if lp.multiSelect && lp.optionsChecked[item.Key].Value {
+			return i
+		}
+		if !lp.multiSelect && item.Key == lp.currentValue {
+			return i
+		}
+	}
+	return 0
 }
 
 func (lp *ListPreferenceModal) OnDismiss() {}
@@ -174,6 +325,20 @@ func (lp *ListPreferenceModal) UseCustomWidget(layout layout.Widget) *ListPrefer
 	return lp
 }
 
+// Searchable shows a search field above the options that filters them by
+// their displayed value. Filtering doesn't change the selected option.
+func (lp *ListPreferenceModal) Searchable(searchable bool) *ListPreferenceModal {
+	lp.searchable = searchable
+	return lp
+}
+
+// ItemDescriptions sets the subtitles shown under the options, keyed by the
+// option's key. Options without a description show only their label.
+func (lp *ListPreferenceModal) ItemDescriptions(descriptions map[string]string) *ListPreferenceModal {
+	lp.descriptions = descriptions
+	return lp
+}
+
 func (lp *ListPreferenceModal) IsWallet(setAccount bool) *ListPreferenceModal {
 	lp.isWalletAccount = setAccount
 	return lp
@@ -185,12 +350,28 @@ func (lp *ListPreferenceModal) UpdateValues(clicked func(val string)) *ListPrefe
 }
 
 func (lp *ListPreferenceModal) Handle(gtx C) {
-	if lp.btnSave.Button.Clicked(gtx) {
+	if lp.btnSave.Clicked(gtx) {
+		// Saving some preferences (e.g. the exchange rate source) may take a
+		// while, show the button as loading until the value is persisted.
+		lp.btnSave.SetLoading(true)
+		lp.btnCancel.SetEnabled(false)
 		lp.currentValue = lp.optionsRadioGroup.Value
-		lp.SavePreferenceKeyedValue()
-		lp.updateButtonClicked(lp.optionsRadioGroup.Value)
-		lp.RefreshTheme(lp.ParentWindow())
-		lp.Dismiss()
+		if lp.multiSelect {
+			lp.currentValue = lp.checkedValue()
+		}
+		go func() {
+			lp.SavePreferenceKeyedValue()
+			lp.isSaved.Store(true)
+			lp.ParentWindow().Reload()
+		}()
+	}
+
+	if lp.isSaved.CompareAndSwap(true, false) {
+		lp.saveCompleted()
+	}
+
+	if lp.btnSave.IsLoading() {
+		return
 	}
 
 	if lp.btnCancel.Button.Clicked(gtx) {
@@ -202,6 +383,18 @@ func (lp *ListPreferenceModal) Handle(gtx C) {
 	}
 }
 
+// saveCompleted dismisses the modal once the selected value is persisted.
+// Callers are only notified, and the theme only refreshed, if the value was
+// changed as that may trigger expensive work.
+func (lp *ListPreferenceModal) saveCompleted() {
+	lp.btnSave.SetLoading(false)
+	if lp.currentValue != lp.initialValue {
+		lp.updateButtonClicked(lp.currentValue)
+		lp.RefreshTheme(lp.ParentWindow())
+	}
+	lp.Dismiss()
+}
+
 func (lp *ListPreferenceModal) Layout(gtx C) D {
 	var w []layout.Widget
 
@@ -213,7 +406,27 @@ func (lp *ListPreferenceModal) Layout(gtx C) D {
 
 	items := []layout.Widget{
 		func(gtx C) D {
-			return layout.Flex{Axis: layout.Vertical}.Layout(gtx, lp.layoutItems()...)
+			options, warningText := lp.layoutItems()
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(func(gtx C) D {
+					if !lp.searchable {
+						return D{}
+					}
+					return layout.Inset{Bottom: values.MarginPadding10}.Layout(gtx, lp.searchEditor.Layout)
+				}),
+				layout.Rigid(func(gtx C) D {
+					gtx.Constraints.Max.Y = min(gtx.Constraints.Max.Y, gtx.Dp(maxOptionsListHeight))
+					return lp.Theme.List(lp.optionsList).Layout(gtx, len(options), func(gtx C, i int) D {
+						return options[i](gtx)
+					})
+				}),
+				layout.Rigid(func(gtx C) D {
+					if warningText == "" {
+						return D{}
+					}
+					return lp.warningLayout(gtx, warningText)
+				}),
+			)
 		},
 		func(gtx C) D {
 			return layout.E.Layout(gtx, func(gtx C) D {
@@ -313,32 +526,74 @@ func (lp *ListPreferenceModal) warningLayout(gtx C, text string) D {
 	})
 }
 
-func (lp *ListPreferenceModal) layoutItems() []layout.FlexChild {
-	items := make([]layout.FlexChild, 0)
+// layoutItems returns the widgets of the options and the warning of the
+// selected option, if any.
+func (lp *ListPreferenceModal) layoutItems() ([]layout.Widget, string) {
+	items := make([]layout.Widget, 0, len(lp.preferenceItems))
 	warningText := ""
 	currentValue := lp.optionsRadioGroup.Value
+	query := ""
+	if lp.searchable {
+		query = lp.searchEditor.Editor.Text()
+	}
 	for _, v := range lp.preferenceItems {
-		text := values.String(v.Value)
-		if lp.isWalletAccount {
-			text = v.Value
-		}
-
+		text := lp.displayValue(v)
 		if currentValue == v.Key {
 			warningText = v.Warning
 		}
 
-		radioItem := layout.Rigid(lp.Theme.RadioButton(lp.optionsRadioGroup, v.Key, text, lp.Theme.Color.DeepBlue, lp.Theme.Color.Primary).Layout)
-		items = append(items, radioItem)
-	}
-	if warningText != "" {
-		warningChild := layout.Rigid(func(gtx layout.Context) layout.Dimensions {
-			return lp.warningLayout(gtx, warningText)
+		if !matchesQuery(text, query) {
+			continue
+		}
+
+		option := lp.Theme.RadioButton(lp.optionsRadioGroup, v.Key, text, lp.Theme.Color.DeepBlue, lp.Theme.Color.Primary).Layout
+		if lp.multiSelect {
+			option = lp.Theme.CheckBox(lp.optionsChecked[v.Key], text).Layout
+		}
+
+		description := lp.descriptions[v.Key]
+		if description == "" {
+			items = append(items, option)
+			continue
+		}
+
+		if !lp.isWalletAccount {
+			description = values.String(description)
+		}
+		items = append(items, func(gtx C) D {
+			return layout.Flex{Axis: layout.Vertical}.Layout(gtx,
+				layout.Rigid(option),
+				layout.Rigid(func(gtx C) D {
+					// Align the description with the option's label.
+					return layout.Inset{
+						Left:   values.MarginPadding30,
+						Bottom: values.MarginPadding5,
+					}.Layout(gtx, func(gtx C) D {
+						lbl := lp.Theme.Caption(description)
+						lbl.Color = lp.Theme.Color.GrayText2
+						return lbl.Layout(gtx)
+					})
+				}),
+			)
 		})
+	}
+
+	return items, warningText
+}
 
-		items = append(items, warningChild)
+// displayValue returns the text shown for the option.
+func (lp *ListPreferenceModal) displayValue(item ItemPreference) string {
+	if lp.isWalletAccount {
+		return item.Value
 	}
+	return values.String(item.Value)
+}
 
-	return items
+// matchesQuery returns true if text contains the query, ignoring case and
+// surrounding spaces. An empty query matches any text.
+func matchesQuery(text, query string) bool {
+	query = strings.TrimSpace(query)
+	return query == "" || strings.Contains(strings.ToLower(text), strings.ToLower(query))
 }
 
 // GetKeyValue return the value for a key within a set of prefence options.
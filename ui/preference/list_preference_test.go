@@ -0,0 +1,50 @@
+package preference
+
+import (
+	"testing"
+
+	"github.com/crypto-power/cryptopower/app"
+	"github.com/crypto-power/cryptopower/ui/cryptomaterial"
+)
+
+func TestSaveCompletedSkipsUnchangedValue(t *testing.T) {
+	var called bool
+	lp := &ListPreferenceModal{
+		Modal:        &cryptomaterial.Modal{GenericPageModal: app.NewGenericPageModal("list_preference")},
+		initialValue: "en",
+		currentValue: "en",
+		updateButtonClicked: func(string) {
+			called = true
+		},
+	}
+	lp.OnAttachedToNavigator(app.NewSimpleWindowNavigator(nil))
+	lp.btnSave.SetLoading(true)
+
+	lp.saveCompleted()
+
+	if called {
+		t.Fatal("expected the update callback to be skipped for an unchanged value")
+	}
+	if lp.btnSave.IsLoading() {
+		t.Fatal("expected the save button to stop loading")
+	}
+}
+
+func TestMatchesQuery(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        bool
+	}{
+		{text: "US Dollar", query: "", want: true},
+		{text: "US Dollar", query: "  ", want: true},
+		{text: "US Dollar", query: "dollar", want: true},
+		{text: "US Dollar", query: " US ", want: true},
+		{text: "Euro", query: "dollar", want: false},
+	}
+
+	for _, test := range tests {
+		if got := matchesQuery(test.text, test.query); got != test.want {
+			t.Fatalf("matchesQuery(%q, %q): expected %v, got %v", test.text, test.query, test.want, got)
+		}
+	}
+}
@@ -45,7 +45,7 @@ func RenderHTML(html string, theme *cryptomaterial.Theme) *HTMLProvider {
 	converter := md.NewConverter("", true, nil)
 	docStr, err := converter.ConvertString(htmlProvider.prepare(html))
 	if err != nil {
-		fmt.Println(err)
+		log.Errorf("error converting html to markdown: %v", err)
 		return &HTMLProvider{}
 	}
 
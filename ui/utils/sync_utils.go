@@ -34,7 +34,7 @@ func (pi ProgressInfo) SyncProgress() int {
 
 type SyncInfo struct {
 	progressInfo sync.Map //map[sharedW.Asset]ProgressInfo
-	rescanInfo   sync.Map //map[sharedW.Asset]*sharedW.HeadersRescanProgressReport
+	rescanInfo   sync.Map //map[sharedW.Asset]sharedW.RescanProgress
 }
 
 // NewSyncProgressInfo returns an instance of the SyncInfo with the respective
@@ -93,13 +93,16 @@ func (si *SyncInfo) IsRescanProgressSet(wallet sharedW.Asset) bool {
 
 // GetRescanProgress returns the progress report associated with the provided
 // asset type.
-func (si *SyncInfo) GetRescanProgress(wallet sharedW.Asset) *sharedW.HeadersRescanProgressReport {
+func (si *SyncInfo) GetRescanProgress(wallet sharedW.Asset) sharedW.RescanProgress {
 	data, _ := si.rescanInfo.Load(wallet)
-	return data.(*sharedW.HeadersRescanProgressReport)
+	if data == nil {
+		return sharedW.RescanProgress{}
+	}
+	return data.(sharedW.RescanProgress)
 }
 
 // SetRescanProgress updates the Rescan progress for the provided asset type.
-func (si *SyncInfo) SetRescanProgress(wallet sharedW.Asset, data *sharedW.HeadersRescanProgressReport) {
+func (si *SyncInfo) SetRescanProgress(wallet sharedW.Asset, data sharedW.RescanProgress) {
 	si.rescanInfo.Store(wallet, data)
 }
 
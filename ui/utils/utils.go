@@ -14,6 +14,7 @@ import (
 	"decred.org/dcrdex/dex/encode"
 	"github.com/crypto-power/cryptopower/libwallet/assets/btc"
 	"github.com/crypto-power/cryptopower/libwallet/assets/dcr"
+	"github.com/crypto-power/cryptopower/libwallet/assets/ltc"
 	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
 	"github.com/crypto-power/cryptopower/libwallet/utils"
 	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
@@ -181,3 +182,39 @@ func IsImportedAccount(assetType libutils.AssetType, acc *sharedW.Account) bool
 		return false
 	}
 }
+
+// AssetAmount wraps amount in the amount type of the provided asset so it is
+// scaled and labelled with the right unit. Unknown asset types fall back to
+// the DCR amount type.
+func AssetAmount(assetType libutils.AssetType, amount int64) sharedW.AssetAmount {
+	switch assetType {
+	case libutils.BTCWalletAsset:
+		return btc.Amount(amount)
+
+	case libutils.LTCWalletAsset:
+		return ltc.Amount(amount)
+
+	default:
+		return dcr.Amount(amount)
+	}
+}
+
+// IsAccountNameTaken returns true if wallet already has an account named name.
+// Names are compared case-insensitively, ignoring surrounding whitespace.
+func IsAccountNameTaken(wallet sharedW.Asset, name string) bool {
+	accounts, err := wallet.GetAccountsRaw()
+	if err != nil {
+		return false
+	}
+	return accountNameTaken(accounts.Accounts, name)
+}
+
+func accountNameTaken(accounts []*sharedW.Account, name string) bool {
+	name = strings.TrimSpace(name)
+	for _, acc := range accounts {
+		if strings.EqualFold(strings.TrimSpace(acc.Name), name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+
+	sharedW "github.com/crypto-power/cryptopower/libwallet/assets/wallet"
+	libutils "github.com/crypto-power/cryptopower/libwallet/utils"
+)
+
+func TestAssetAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		assetType libutils.AssetType
+		amount    int64
+		want      string
+	}{{
+		name:      "btc single satoshi",
+		assetType: libutils.BTCWalletAsset,
+		amount:    1,
+		want:      "0.00000001 BTC",
+	}, {
+		name:      "btc full precision",
+		assetType: libutils.BTCWalletAsset,
+		amount:    123456789,
+		want:      "1.23456789 BTC",
+	}, {
+		name:      "ltc",
+		assetType: libutils.LTCWalletAsset,
+		amount:    150000000,
+		want:      "1.50000000 LTC",
+	}, {
+		name:      "dcr",
+		assetType: libutils.DCRWalletAsset,
+		amount:    150000000,
+		want:      "1.5 DCR",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := AssetAmount(test.assetType, test.amount).String()
+			if got != test.want {
+				t.Fatalf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestAccountNameTaken(t *testing.T) {
+	accounts := []*sharedW.Account{{Name: "default"}, {Name: "Savings"}}
+	tests := []struct {
+		name        string
+		accountName string
+		want        bool
+	}{{
+		name:        "exact match",
+		accountName: "default",
+		want:        true,
+	}, {
+		name:        "different case",
+		accountName: "SAVINGS",
+		want:        true,
+	}, {
+		name:        "surrounding whitespace",
+		accountName: "  Default ",
+		want:        true,
+	}, {
+		name:        "new name",
+		accountName: "spending",
+		want:        false,
+	}, {
+		name:        "prefix of existing name",
+		accountName: "save",
+		want:        false,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := accountNameTaken(accounts, test.accountName); got != test.want {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
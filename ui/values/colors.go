@@ -72,6 +72,11 @@ type Color struct {
 	OrangeYellow     color.NRGBA
 	White            color.NRGBA
 	Warning          color.NRGBA
+
+	// asset badge colors
+	DCRBadge color.NRGBA
+	BTCBadge color.NRGBA
+	LTCBadge color.NRGBA
 }
 
 func (c *Color) DarkThemeColors() {
@@ -155,6 +160,11 @@ func (c *Color) DefaultThemeColors() *Color {
 		OrangeYellow:     rgb(0xd8a93e),
 		White:            rgb(0xffffff),
 		Warning:          rgb(0xff9966),
+
+		// asset badge colors
+		DCRBadge: rgb(0x2DD8A3),
+		BTCBadge: rgb(0xF7931A),
+		LTCBadge: rgb(0x345D9D),
 	}
 
 	return &cl
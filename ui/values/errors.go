@@ -20,6 +20,12 @@ func TranslateErr(errStr string) string {
 	case utils.ErrNotConnected:
 		return String(StrNotConnected)
 
+	case utils.ErrNotSynced:
+		return String(StrWalletNotSynced)
+
+	case utils.ErrWalletIsWatchOnly:
+		return String(StrWatchOnlyCantTransact)
+
 	case utils.ErrInsufficientBalance:
 		return String(StrInsufficientFund)
 
@@ -956,4 +956,112 @@ const EN = `
 "privacy" = "Privacy"
 "removeRecipient" = "Remove recipient"
 "removeRecipientWarning" = "Are you sure you want to proceed with removing the recipient?"
+"minFeeRate" = "Min. Fee Rate%v"
+"advancedMode" = "Advanced mode"
+"pendingOperations" = "Operations in progress"
+"pendingOperationsWarning" = "The following operations are still in progress: %v. Leaving now may interrupt them. Are you sure you want to proceed?"
+"broadcastingTx" = "Broadcasting transaction"
+"placingOrder" = "Placing exchange order"
+"addressBalance" = "Address balance: %v (unconfirmed: %v)"
+"tag" = "Tag"
+"allTags" = "All tags"
+"income" = "Income"
+"expense" = "Expense"
+"transfer" = "Transfer"
+"startupWallet" = "Startup wallet"
+"firstWallet" = "First wallet"
+"verifyIntegrity" = "Verify wallet integrity"
+"verifyingIntegrity" = "Verifying wallet data · %v%%"
+"repairingIntegrity" = "Repairing wallet data · %v%%"
+"integrityOK" = "No wallet integrity issues found"
+"integrityIssuesFound" = "Wallet integrity issues found"
+"integrityRepairable" = "These issues can be repaired. The affected block headers will be fetched again and the wallet will be rescanned on the next sync."
+"integrityNotRepairable" = "These issues can't be repaired automatically. Restore the wallet from its seed to recover it."
+"integrityRepaired" = "Wallet data repaired. Reconnect to sync the wallet again."
+"disconnectToVerify" = "Disconnect the wallet from the network before verifying its integrity."
+"repair" = "Repair"
+"accountNameTaken" = "An account with this name already exists"
+"sats" = "sats"
+"atoms" = "atoms"
+"litoshis" = "litoshis"
+"lockOnBackground" = "Lock wallets when the app is minimized"
+"explorerAPI" = "Block Explorer API"
+"verifyWithExplorer" = "Verify address with block explorer"
+"explorerPrivacyWarning" = "The address will be looked up on %v, an external block explorer service. This reveals the address and your IP address to the service. Continue?"
+"explorerAPIDisabled" = "Enable the Block Explorer API in the app's privacy settings to verify addresses before the wallet is synced."
+"addressHasHistory" = "%v was used in %v transactions and received %v in total. The wallet was restored correctly."
+"addressHasNoHistory" = "No transactions were found for %v."
+"addressNotInWallet" = "This address does not belong to this wallet"
+"selectAccounts" = "Select accounts"
+"accountsSelected" = "%d accounts selected"
+"selectionShort" = "The selected coins are %v short of the amount to send plus the fee"
+"selectionChange" = "Change after the amount to send and the fee: %v"
+"sortedBy" = "Sorted by: %v"
+"purchaseTickets" = "Purchase Tickets"
+"numberOfTickets" = "Number of tickets"
+"invalidTicketCount" = "Enter a whole number of tickets greater than zero"
+"ticketsPurchased" = "%d ticket(s) purchased successfully"
+"ticketsToPurchase" = "Tickets to purchase: %d"
+"exportCSV" = "Export CSV"
+"exportStakingStatsSuccessMsg" = "Your staking statistics have been exported successfully and saved to %s."
+"maxTicketsPerWindow" = "Max tickets per price window (optional)"
+"maxTicketsPerWindowValue" = "Max tickets per price window: %s"
+"invalidMaxTickets" = "Enter a whole number of tickets, or leave empty for no limit"
+"noLimit" = "No limit"
+"vspOnline" = "Online"
+"vspOffline" = "Offline"
+"vspLastSeen" = "Last seen %s"
+"checkingVSP" = "Checking..."
+"unmixedTicketPurchaseWarn" = "Tickets will be purchased from an account that is not the mixed account. Purchases from unmixed funds could be traced back to you."
+"proceedAnyway" = "Proceed anyway"
+"applyToAllWallets" = "Apply to all wallets"
+"policySetOnSomeWallets" = "Policy set on %d of %d wallets. Skipped wallets:\n%s"
+"voteHistory" = "Vote history"
+"noPriorVote" = "No prior vote"
+"policyHistoryEntry" = "%s at block %d, %s"
+"noPiKeys" = "Treasury policies can't be loaded, no governance keys are known for this network"
+"selectAll" = "Select all"
+"spendableInfo" = "The spendable balance can be used right away. The total balance also includes unconfirmed funds, immature rewards and funds that are locked, which can't be spent yet."
+"lockedInTicketsInfo" = "Locked in tickets: %s"
+"watchOnlyCantSign" = "%s is a watch-only wallet. It can view its balance and transactions but can't sign transactions. You can export an unsigned transaction instead and sign it offline with the wallet that holds the private keys."
+"exportUnsignedTx" = "Export unsigned transaction"
+"unsignedTxExported" = "The unsigned transaction was saved to %s. Sign it with the wallet that holds the private keys, then import the signed transaction to broadcast it."
+"importSignedTx" = "Import signed transaction"
+"signedTxHint" = "Signed transaction hex or file path"
+"vspFeeUnconfirmed" = "VSP fee unconfirmed"
+"vspFeeUnconfirmedInfo" = "The fee of this ticket hasn't been confirmed by %s (%s). The VSP won't vote the ticket until the fee is confirmed. Open the ticket with the wallet unlocked to retry processing the fee."
+"nextPriceChangeIn" = "Next price change in"
+"imminent" = "imminent"
+"durationDays" = "%dd"
+"durationHours" = "%dh"
+"durationMinutes" = "%dm"
+"durationSeconds" = "%ds"
+"stakedValue" = "Staked Value"
+"balanceBreakdown" = "Balance breakdown"
+"hideFromWalletSelectors" = "Hide from wallet selectors"
+"customOrder" = "Custom order"
+"addLabel" = "Add label"
+"editTxLabel" = "Edit transaction label"
+"txLabelLengthError" = "Label must not exceed %d characters"
+"editAddressLabel" = "Edit address label"
+"autoSelect" = "Auto select"
+"fewestCoins" = "Fewest coins"
+"consolidateCoins" = "Consolidate coins"
+"enterAmountToAutoSelect" = "Enter the amount to send to auto select coins"
+"dust" = "dust"
+"hideDust" = "Hide dust"
+"maturesInBlocks" = "Matures in %d blocks"
+"watchOnlyCantTransact" = "Watch-only wallets can't sign transactions"
+"birthdayHeight" = "Birthday block height (optional)"
+"invalidBirthdayHeight" = "Enter a block height of zero or more, or leave empty to scan from the genesis block"
+"noOtherAccount" = "No other account to send to"
+"walletOrder" = "Wallet order"
+"economy" = "Economy"
+"normal" = "Normal"
+"highPriority" = "Priority"
+"connectToEstimateFees" = "Connect to the network to estimate fees"
+"txReceivedNotificationDesc" = "Notify when the wallet receives funds"
+"ticketVotedNotificationDesc" = "Notify when a ticket votes, with the reward earned"
+"ticketRevokedNotificationDesc" = "Notify when a ticket is revoked"
+"newBlockNotificationDesc" = "Beep each time a new block is mined"
 `
@@ -1065,4 +1065,112 @@ const (
 	StrPrivacy                               = "privacy"
 	StrRemoveRecipient                       = "removeRecipient"
 	StrRemoveRecipientWarning                = "removeRecipientWarning"
+	StrMinFeeRate                            = "minFeeRate"
+	StrAdvancedMode                          = "advancedMode"
+	StrPendingOperations                     = "pendingOperations"
+	StrPendingOperationsWarning              = "pendingOperationsWarning"
+	StrBroadcastingTx                        = "broadcastingTx"
+	StrPlacingOrder                          = "placingOrder"
+	StrAddressBalance                        = "addressBalance"
+	StrTag                                   = "tag"
+	StrAllTags                               = "allTags"
+	StrIncome                                = "income"
+	StrExpense                               = "expense"
+	StrTransfer                              = "transfer"
+	StrStartupWallet                         = "startupWallet"
+	StrFirstWallet                           = "firstWallet"
+	StrVerifyIntegrity                       = "verifyIntegrity"
+	StrVerifyingIntegrity                    = "verifyingIntegrity"
+	StrRepairingIntegrity                    = "repairingIntegrity"
+	StrIntegrityOK                           = "integrityOK"
+	StrIntegrityIssuesFound                  = "integrityIssuesFound"
+	StrIntegrityRepairable                   = "integrityRepairable"
+	StrIntegrityNotRepairable                = "integrityNotRepairable"
+	StrIntegrityRepaired                     = "integrityRepaired"
+	StrDisconnectToVerify                    = "disconnectToVerify"
+	StrRepair                                = "repair"
+	StrAccountNameTaken                      = "accountNameTaken"
+	StrSats                                  = "sats"
+	StrAtoms                                 = "atoms"
+	StrLitoshis                              = "litoshis"
+	StrLockOnBackground                      = "lockOnBackground"
+	StrExplorerAPI                           = "explorerAPI"
+	StrVerifyWithExplorer                    = "verifyWithExplorer"
+	StrExplorerPrivacyWarning                = "explorerPrivacyWarning"
+	StrExplorerAPIDisabled                   = "explorerAPIDisabled"
+	StrAddressHasHistory                     = "addressHasHistory"
+	StrAddressHasNoHistory                   = "addressHasNoHistory"
+	StrAddressNotInWallet                    = "addressNotInWallet"
+	StrSelectAccounts                        = "selectAccounts"
+	StrAccountsSelected                      = "accountsSelected"
+	StrSelectionShort                        = "selectionShort"
+	StrSelectionChange                       = "selectionChange"
+	StrSortedBy                              = "sortedBy"
+	StrPurchaseTickets                       = "purchaseTickets"
+	StrNumberOfTickets                       = "numberOfTickets"
+	StrInvalidTicketCount                    = "invalidTicketCount"
+	StrTicketsPurchased                      = "ticketsPurchased"
+	StrTicketsToPurchase                     = "ticketsToPurchase"
+	StrExportCSV                             = "exportCSV"
+	StrExportStakingStatsSuccessMsg          = "exportStakingStatsSuccessMsg"
+	StrMaxTicketsPerWindow                   = "maxTicketsPerWindow"
+	StrMaxTicketsPerWindowValue              = "maxTicketsPerWindowValue"
+	StrInvalidMaxTickets                     = "invalidMaxTickets"
+	StrNoLimit                               = "noLimit"
+	StrVSPOnline                             = "vspOnline"
+	StrVSPOffline                            = "vspOffline"
+	StrVSPLastSeen                           = "vspLastSeen"
+	StrCheckingVSP                           = "checkingVSP"
+	StrUnmixedTicketPurchaseWarn             = "unmixedTicketPurchaseWarn"
+	StrProceedAnyway                         = "proceedAnyway"
+	StrApplyToAllWallets                     = "applyToAllWallets"
+	StrPolicySetOnSomeWallets                = "policySetOnSomeWallets"
+	StrVoteHistory                           = "voteHistory"
+	StrNoPriorVote                           = "noPriorVote"
+	StrPolicyHistoryEntry                    = "policyHistoryEntry"
+	StrNoPiKeys                              = "noPiKeys"
+	StrSelectAll                             = "selectAll"
+	StrSpendableInfo                         = "spendableInfo"
+	StrLockedInTicketsInfo                   = "lockedInTicketsInfo"
+	StrWatchOnlyCantSign                     = "watchOnlyCantSign"
+	StrExportUnsignedTx                      = "exportUnsignedTx"
+	StrUnsignedTxExported                    = "unsignedTxExported"
+	StrImportSignedTx                        = "importSignedTx"
+	StrSignedTxHint                          = "signedTxHint"
+	StrVSPFeeUnconfirmed                     = "vspFeeUnconfirmed"
+	StrVSPFeeUnconfirmedInfo                 = "vspFeeUnconfirmedInfo"
+	StrNextPriceChangeIn                     = "nextPriceChangeIn"
+	StrImminent                              = "imminent"
+	StrDurationDays                          = "durationDays"
+	StrDurationHours                         = "durationHours"
+	StrDurationMinutes                       = "durationMinutes"
+	StrDurationSeconds                       = "durationSeconds"
+	StrStakedValue                           = "stakedValue"
+	StrBalanceBreakdown                      = "balanceBreakdown"
+	StrHideFromWalletSelectors               = "hideFromWalletSelectors"
+	StrCustomOrder                           = "customOrder"
+	StrAddLabel                              = "addLabel"
+	StrEditTxLabel                           = "editTxLabel"
+	StrTxLabelLengthError                    = "txLabelLengthError"
+	StrEditAddressLabel                      = "editAddressLabel"
+	StrAutoSelect                            = "autoSelect"
+	StrFewestCoins                           = "fewestCoins"
+	StrConsolidateCoins                      = "consolidateCoins"
+	StrEnterAmountToAutoSelect               = "enterAmountToAutoSelect"
+	StrDust                                  = "dust"
+	StrHideDust                              = "hideDust"
+	StrMaturesInBlocks                       = "maturesInBlocks"
+	StrWatchOnlyCantTransact                 = "watchOnlyCantTransact"
+	StrBirthdayHeight                        = "birthdayHeight"
+	StrInvalidBirthdayHeight                 = "invalidBirthdayHeight"
+	StrNoOtherAccount                        = "noOtherAccount"
+	StrWalletOrder                           = "walletOrder"
+	StrEconomy                               = "economy"
+	StrNormal                                = "normal"
+	StrHighPriority                          = "highPriority"
+	StrConnectToEstimateFees                 = "connectToEstimateFees"
+	StrTxReceivedNotificationDesc            = "txReceivedNotificationDesc"
+	StrTicketVotedNotificationDesc           = "ticketVotedNotificationDesc"
+	StrTicketRevokedNotificationDesc         = "ticketRevokedNotificationDesc"
+	StrNewBlockNotificationDesc              = "newBlockNotificationDesc"
 )
@@ -52,6 +52,10 @@ type Window struct {
 	drag       gesture.Drag
 	isClick    bool
 	isDragging bool
+
+	// isBackgrounded is true while the window is minimized or, on mobile
+	// platforms, while the app is in the background.
+	isBackgrounded bool
 }
 
 type (
@@ -193,37 +197,46 @@ func (win *Window) HandleEvents() {
 			win.Quit <- struct{}{}
 		}
 
-		if !win.load.AssetsManager.DEXCInitialized() || windowAlreadyDestroyed {
+		if windowAlreadyDestroyed {
 			doShutdown()
 			return
 		}
 
-		ord, inflight, err := win.load.AssetsManager.DexClient().ActiveOrders()
-		if err != nil {
-			log.Errorf("AssetsManager.DexClient().ActiveOrders error: %v", err)
-			return
-		}
+		// Give the user a chance to cancel the shutdown if a broadcast or
+		// any other in-flight operation would be interrupted by it.
+		modal.ConfirmPendingOperations(win.load, win.navigator, func() {
+			if !win.load.AssetsManager.DEXCInitialized() {
+				doShutdown()
+				return
+			}
 
-		if len(ord) == 0 && len(inflight) == 0 {
-			doShutdown()
-			return
-		}
+			ord, inflight, err := win.load.AssetsManager.DexClient().ActiveOrders()
+			if err != nil {
+				log.Errorf("AssetsManager.DexClient().ActiveOrders error: %v", err)
+				return
+			}
 
-		// User has active orders, show an error modal and only allow shutdown
-		// if user allows it.
-		m := modal.NewErrorModal(win.load, values.String(values.StrDexError), modal.DefaultClickFunc())
-		m.SetPositiveButtonText(values.String(values.StrYes)).SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
-			doShutdown()
-			return true
-		}).
-			SetNegativeButtonText(values.String(values.StrNo)).
-			SetNegativeButtonCallback(func() {
-				win.navigator.Display(win.navigator.CurrentPage())
-			}).
-			SetCancelable(true).
-			Body(values.String(values.StrActiveDexOrderError))
+			if len(ord) == 0 && len(inflight) == 0 {
+				doShutdown()
+				return
+			}
 
-		win.navigator.ShowModal(m)
+			// User has active orders, show an error modal and only allow shutdown
+			// if user allows it.
+			m := modal.NewErrorModal(win.load, values.String(values.StrDexError), modal.DefaultClickFunc())
+			m.SetPositiveButtonText(values.String(values.StrYes)).SetPositiveButtonCallback(func(_ bool, _ *modal.InfoModal) bool {
+				doShutdown()
+				return true
+			}).
+				SetNegativeButtonText(values.String(values.StrNo)).
+				SetNegativeButtonCallback(func() {
+					win.navigator.Display(win.navigator.CurrentPage())
+				}).
+				SetCancelable(true).
+				Body(values.String(values.StrActiveDexOrderError))
+
+			win.navigator.ShowModal(m)
+		})
 	}
 
 	// Create window chan event and listen events from window event
@@ -258,6 +271,8 @@ func (win *Window) HandleEvents() {
 			case giouiApp.FrameEvent:
 				ops := win.handleFrameEvent(evt)
 				evt.Frame(ops)
+			case giouiApp.ConfigEvent:
+				win.handleConfigEvent(evt)
 			default:
 				log.Tracef("Unhandled window event %v\n", e)
 			}
@@ -266,6 +281,21 @@ func (win *Window) HandleEvents() {
 	}
 }
 
+// handleConfigEvent locks the wallets, if the user enabled it, when the window
+// is minimized or the app is sent to the background.
+func (win *Window) handleConfigEvent(evt giouiApp.ConfigEvent) {
+	isBackgrounded := evt.Config.Mode == giouiApp.Minimized
+	if runtime.GOOS == "android" || runtime.GOOS == "ios" {
+		// Mobile apps lose focus when they are sent to the background.
+		isBackgrounded = isBackgrounded || !evt.Config.Focused
+	}
+
+	if isBackgrounded && !win.isBackgrounded {
+		go win.load.LockWalletsOnBackground()
+	}
+	win.isBackgrounded = isBackgrounded
+}
+
 // handleFrameEvent is called when a FrameEvent is received by the active
 // window. It expects a new frame in the form of a list of operations that
 // describes what to display and how to handle input. This operations list